@@ -43,6 +43,9 @@ func runConfig(cmd *cobra.Command, args []string) error {
 	} else {
 		cmd.Printf("  Auth Token: Not set\n")
 	}
+	if cfg.PerRequestAuth {
+		cmd.Printf("  Per-Request Auth: enabled (client cache size %d)\n", cfg.ClientCacheSize)
+	}
 
 	// Validate configuration
 	err = cfg.Validate()
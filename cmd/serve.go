@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/kunde21/forgejo-mcp/server"
@@ -23,14 +25,17 @@ func NewServeCmd() *cobra.Command {
 		Short: "Start the MCP server",
 		Long: `Start the Model Context Protocol server for Forgejo integration.
 
-The server will listen for MCP requests on stdin/stdout and provide
-tools for interacting with Forgejo repositories.`,
+By default the server listens for MCP requests on stdin/stdout. Use
+--transport=http to serve the streamable HTTP transport at /mcp, or
+--transport=sse to serve the legacy HTTP+SSE transport at /sse, on the
+address given by --host and --port.`,
 		RunE: runServe,
 	}
 
 	// Add serve-specific flags
 	cmd.Flags().String("host", "localhost", "Host to bind the server to")
 	cmd.Flags().Int("port", 3000, "Port to bind the server to")
+	cmd.Flags().String("transport", server.TransportStdio, "Transport to serve: stdio, http (streamable HTTP), or sse (legacy HTTP+SSE)")
 	cmd.Flags().Bool("debug", false, "Enable debug mode (exposes hello tool)")
 	cmd.Flags().Bool("compat", false, "Enable compatibility mode (detailed text responses)")
 
@@ -65,7 +70,22 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get compat flag: %w", err)
 	}
 
-	log.Printf("Starting MCP server on %s:%d", host, port)
+	transport, err := cmd.Flags().GetString("transport")
+	if err != nil {
+		return fmt.Errorf("failed to get transport flag: %w", err)
+	}
+	switch transport {
+	case server.TransportStdio, server.TransportHTTP, server.TransportSSE:
+	default:
+		return fmt.Errorf("invalid transport %q: must be one of stdio, http, sse", transport)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	if transport == server.TransportStdio {
+		log.Println("Starting MCP server on stdio")
+	} else {
+		log.Printf("Starting MCP server on %s (%s transport)", addr, transport)
+	}
 
 	// Initialize the MCP server with official SDK
 	srv, err := server.NewWithDebugAndCompat(debug, compat)
@@ -80,9 +100,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 	// Start server in background goroutine to allow concurrent signal handling
 	errChan := make(chan error, 1)
 	go func() {
-		if err := srv.Start(); err != nil {
-			errChan <- err
-		}
+		errChan <- srv.StartWithTransport(transport, addr)
 	}()
 
 	log.Println("MCP server started successfully")
@@ -91,7 +109,11 @@ func runServe(cmd *cobra.Command, args []string) error {
 	// Wait for either an error or shutdown signal
 	select {
 	case err := <-errChan:
-		return fmt.Errorf("server error: %v", err)
+		if err != nil {
+			return fmt.Errorf("server error: %v", err)
+		}
+		log.Println("Server stopped")
+		return nil
 	case <-ctx.Done():
 		log.Println("Shutting down server...")
 		if err := srv.Stop(); err != nil {
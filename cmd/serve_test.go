@@ -36,3 +36,36 @@ func TestServeCompatFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestServeTransportFlag(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"default", []string{"serve"}, "stdio"},
+		{"streamable http", []string{"serve", "--transport", "http"}, "http"},
+		{"legacy sse", []string{"serve", "--transport=sse"}, "sse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewServeCmd()
+			cmd.SetArgs(tt.args)
+
+			err := cmd.ParseFlags(tt.args)
+			if err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			transport, err := cmd.Flags().GetString("transport")
+			if err != nil {
+				t.Fatalf("Failed to get transport flag: %v", err)
+			}
+
+			if transport != tt.expected {
+				t.Errorf("Expected transport=%q, got %q", tt.expected, transport)
+			}
+		})
+	}
+}
@@ -13,8 +13,26 @@ type Config struct {
 	AuthToken  string           `mapstructure:"auth_token"`
 	ClientType string           `mapstructure:"client_type"`
 	Attachment AttachmentConfig `mapstructure:"attachment"`
+
+	// PerRequestAuth enables per-session Forgejo tokens taken from the
+	// Authorization header of HTTP transports. AuthToken becomes optional
+	// and, when set, is used for requests that carry no token.
+	PerRequestAuth  bool `mapstructure:"per_request_auth"`
+	ClientCacheSize int  `mapstructure:"client_cache_size"`
+
+	// AllowDeleteOthersComments lets the comment delete tools remove comments
+	// written by users other than the authenticated one.
+	AllowDeleteOthersComments bool `mapstructure:"allow_delete_others_comments"`
+
+	// MaxFileSize is the size in bytes of the largest repository file that
+	// repo_file_get reads; larger files are refused.
+	MaxFileSize int64 `mapstructure:"max_file_size"`
 }
 
+// AttachmentConfig controls files uploaded with issues, pull requests, comments and releases.
+// AllowedTypes entries match the detected MIME type without parameters exactly or, when ending
+// in "*", by prefix.
+// MaxSize also limits the files downloaded by attachment_get.
 type AttachmentConfig struct {
 	Enabled      bool     `mapstructure:"enabled"`
 	MaxSize      int64    `mapstructure:"max_size"`
@@ -27,6 +45,10 @@ func Load() (*Config, error) {
 	viper.SetDefault("remote_url", "")
 	viper.SetDefault("auth_token", "")
 	viper.SetDefault("client_type", "auto") // Default to auto-detection
+	viper.SetDefault("per_request_auth", false)
+	viper.SetDefault("client_cache_size", 64)
+	viper.SetDefault("allow_delete_others_comments", false)
+	viper.SetDefault("max_file_size", 1024*1024) // 1MB default
 
 	// Attachment defaults
 	viper.SetDefault("attachment.enabled", false)
@@ -39,6 +61,13 @@ func Load() (*Config, error) {
 	viper.BindEnv("remote_url", "FORGEJO_REMOTE_URL")
 	viper.BindEnv("auth_token", "FORGEJO_AUTH_TOKEN")
 	viper.BindEnv("client_type", "FORGEJO_CLIENT_TYPE")
+	viper.BindEnv("per_request_auth", "FORGEJO_PER_REQUEST_AUTH")
+	viper.BindEnv("client_cache_size", "FORGEJO_CLIENT_CACHE_SIZE")
+	viper.BindEnv("allow_delete_others_comments", "FORGEJO_ALLOW_DELETE_OTHERS_COMMENTS")
+	viper.BindEnv("max_file_size", "FORGEJO_MAX_FILE_SIZE")
+	viper.BindEnv("attachment.enabled", "FORGEJO_ATTACHMENT_ENABLED")
+	viper.BindEnv("attachment.max_size", "FORGEJO_ATTACHMENT_MAX_SIZE")
+	viper.BindEnv("attachment.allowed_types", "FORGEJO_ATTACHMENT_ALLOWED_TYPES") // Comma separated
 
 	// Config file support (optional)
 	viper.SetConfigName("config")
@@ -62,9 +91,12 @@ func (c *Config) Validate() error {
 	if c.RemoteURL == "" {
 		return &ValidationError{Field: "RemoteURL", Message: "FORGEJO_REMOTE_URL environment variable or config file remote_url is required"}
 	}
-	if c.AuthToken == "" {
+	if c.AuthToken == "" && !c.PerRequestAuth {
 		return &ValidationError{Field: "AuthToken", Message: "FORGEJO_AUTH_TOKEN environment variable or config file auth_token is required"}
 	}
+	if c.ClientCacheSize < 0 {
+		return &ValidationError{Field: "ClientCacheSize", Message: "client_cache_size must not be negative"}
+	}
 	if c.ClientType != "" && c.ClientType != "gitea" && c.ClientType != "forgejo" && c.ClientType != "auto" {
 		return &ValidationError{Field: "ClientType", Message: "ClientType must be one of: 'gitea', 'forgejo', 'auto' (or empty for auto-detection)"}
 	}
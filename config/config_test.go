@@ -133,6 +133,41 @@ func TestConfig_Validate_ClientType(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_PerRequestAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectError bool
+	}{
+		{
+			name:        "auth token required without per-request auth",
+			config:      Config{RemoteURL: "https://example.com"},
+			expectError: true,
+		},
+		{
+			name:        "auth token optional with per-request auth",
+			config:      Config{RemoteURL: "https://example.com", PerRequestAuth: true},
+			expectError: false,
+		},
+		{
+			name:        "negative client cache size",
+			config:      Config{RemoteURL: "https://example.com", PerRequestAuth: true, ClientCacheSize: -1},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError && err == nil {
+				t.Error("Expected validation error but got none")
+			} else if !tt.expectError && err != nil {
+				t.Errorf("Expected no validation error but got: %v", err)
+			}
+		})
+	}
+}
+
 func TestLoadConfig_WithNewFields(t *testing.T) {
 	os.Setenv("FORGEJO_REMOTE_URL", "https://forgejo.example.com")
 	os.Setenv("FORGEJO_AUTH_TOKEN", "test-token-123")
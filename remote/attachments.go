@@ -0,0 +1,33 @@
+package remote
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+)
+
+// AttachmentMarkdown returns a Markdown link to an attachment. Images are embedded inline.
+func AttachmentMarkdown(attachment Attachment) string {
+	link := fmt.Sprintf("[%s](%s)", attachment.Name, attachment.DownloadURL)
+	if strings.HasPrefix(mime.TypeByExtension(path.Ext(attachment.Name)), "image/") {
+		return "!" + link
+	}
+	return link
+}
+
+// AppendAttachmentLinks returns body followed by a Markdown link to each attachment, one per line
+func AppendAttachmentLinks(body string, attachments []Attachment) string {
+	if len(attachments) == 0 {
+		return body
+	}
+	links := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		links[i] = AttachmentMarkdown(attachment)
+	}
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return strings.Join(links, "\n")
+	}
+	return body + "\n\n" + strings.Join(links, "\n")
+}
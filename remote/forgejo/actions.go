@@ -0,0 +1,275 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// forgejoActionRun mirrors the Forgejo API representation of a workflow run
+type forgejoActionRun struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	Status      string    `json:"status"`
+	Event       string    `json:"event"`
+	IndexInRepo int64     `json:"index_in_repo"`
+	WorkflowID  string    `json:"workflow_id"`
+	PrettyRef   string    `json:"prettyref"`
+	CommitSHA   string    `json:"commit_sha"`
+	HTMLURL     string    `json:"html_url"`
+	Created     time.Time `json:"created"`
+	Started     time.Time `json:"started"`
+	Stopped     time.Time `json:"stopped"`
+	TriggerUser *struct {
+		UserName string `json:"login"`
+	} `json:"trigger_user"`
+}
+
+// forgejoActionJob mirrors the Forgejo API representation of a workflow job
+type forgejoActionJob struct {
+	ID          int64     `json:"id"`
+	RunID       int64     `json:"run_id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	HTMLURL     string    `json:"html_url"`
+}
+
+// actionRunScanPageSize is the page size used when scanning runs for a branch
+const actionRunScanPageSize = 50
+
+// ListActionRuns lists Forgejo Actions workflow runs of a repository, newest first.
+// The runs API cannot filter by branch, so a branch filter scans the runs page by page
+// until the requested page of matches is complete; Total is nil when the scan stopped
+// before the last run.
+func (c *ForgejoClient) ListActionRuns(ctx context.Context, args remote.ListActionRunsArgs) (*remote.ActionRunList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.Limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", args.Limit)
+	}
+
+	query := url.Values{}
+	if args.HeadSHA != "" {
+		query.Set("head_sha", args.HeadSHA)
+	}
+	if args.Status != "" {
+		query.Set("status", args.Status)
+	}
+	if args.Event != "" {
+		query.Set("event", args.Event)
+	}
+	path := fmt.Sprintf("/repos/%s/%s/actions/runs", url.PathEscape(owner), url.PathEscape(repoName))
+
+	var runs []forgejoActionRun
+	var total *int
+	if args.Branch == "" {
+		page, count, err := c.fetchActionRuns(ctx, path, query, args.Offset/args.Limit+1, args.Limit) // Forgejo uses 1-based pagination
+		if err != nil {
+			return nil, fmt.Errorf("failed to list action runs: %w", err)
+		}
+		runs, total = page, &count
+	} else {
+		skipped, scanned, more := 0, 0, false
+		for pageNumber := 1; !more; pageNumber++ {
+			page, count, err := c.fetchActionRuns(ctx, path, query, pageNumber, actionRunScanPageSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list action runs: %w", err)
+			}
+			for _, run := range page {
+				if run.PrettyRef != args.Branch {
+					continue
+				}
+				if skipped < args.Offset {
+					skipped++
+					continue
+				}
+				if len(runs) == args.Limit {
+					// Another match exists beyond this page of results
+					more = true
+					break
+				}
+				runs = append(runs, run)
+			}
+			scanned += len(page)
+			if !more && (len(page) == 0 || scanned >= count) {
+				matched := skipped + len(runs)
+				total = &matched
+				break
+			}
+		}
+	}
+
+	result := make([]remote.ActionRun, len(runs))
+	for i, run := range runs {
+		triggerUser := ""
+		if run.TriggerUser != nil {
+			triggerUser = run.TriggerUser.UserName
+		}
+		result[i] = remote.ActionRun{
+			ID:          int(run.ID),
+			RunNumber:   int(run.IndexInRepo),
+			Title:       run.Title,
+			WorkflowID:  run.WorkflowID,
+			Status:      run.Status,
+			Event:       run.Event,
+			Branch:      run.PrettyRef,
+			CommitSHA:   run.CommitSHA,
+			TriggerUser: triggerUser,
+			Created:     formatActionTime(run.Created),
+			Started:     formatActionTime(run.Started),
+			Stopped:     formatActionTime(run.Stopped),
+			HTMLURL:     run.HTMLURL,
+		}
+	}
+
+	return &remote.ActionRunList{
+		Runs:   result,
+		Total:  total,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	}, nil
+}
+
+// fetchActionRuns fetches one page of workflow runs with the server's count of all matching runs
+func (c *ForgejoClient) fetchActionRuns(ctx context.Context, path string, filters url.Values, page, limit int) ([]forgejoActionRun, int, error) {
+	query := url.Values{}
+	for key, values := range filters {
+		query[key] = values
+	}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("page", strconv.Itoa(page))
+
+	body, status, err := c.apiGet(ctx, path, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// Servers before the runs API was introduced do not route the endpoint at all
+		return nil, 0, fmt.Errorf("%w: actions runs API not available", remote.ErrUnsupported)
+	default:
+		return nil, 0, fmt.Errorf("%s", apiErrorMessage(status, body))
+	}
+
+	var response struct {
+		WorkflowRuns []forgejoActionRun `json:"workflow_runs"`
+		TotalCount   int                `json:"total_count"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, 0, fmt.Errorf("invalid response: %w", err)
+	}
+	return response.WorkflowRuns, response.TotalCount, nil
+}
+
+// ListActionJobs lists the jobs of a Forgejo Actions workflow run
+func (c *ForgejoClient) ListActionJobs(ctx context.Context, repo string, runID int) ([]remote.ActionJob, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if runID <= 0 {
+		return nil, fmt.Errorf("invalid run ID: %d, must be positive", runID)
+	}
+
+	var response struct {
+		Jobs []forgejoActionJob `json:"jobs"`
+	}
+	path := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/jobs", url.PathEscape(owner), url.PathEscape(repoName), runID)
+	body, status, err := c.apiGet(ctx, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list action jobs: %w", err)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("failed to list action jobs: run %d not found in %s", runID, repo)
+	default:
+		return nil, fmt.Errorf("failed to list action jobs: %s", apiErrorMessage(status, body))
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to list action jobs: invalid response: %w", err)
+	}
+
+	jobs := make([]remote.ActionJob, len(response.Jobs))
+	for i, job := range response.Jobs {
+		jobs[i] = remote.ActionJob{
+			ID:         int(job.ID),
+			RunID:      int(job.RunID),
+			Name:       job.Name,
+			Status:     job.Status,
+			Conclusion: job.Conclusion,
+			Started:    formatActionTime(job.StartedAt),
+			Completed:  formatActionTime(job.CompletedAt),
+			HTMLURL:    job.HTMLURL,
+		}
+	}
+
+	return jobs, nil
+}
+
+// GetActionJobLog fetches the full plain-text log of a Forgejo Actions job
+func (c *ForgejoClient) GetActionJobLog(ctx context.Context, repo string, jobID int) (string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if jobID <= 0 {
+		return "", fmt.Errorf("invalid job ID: %d, must be positive", jobID)
+	}
+
+	path := fmt.Sprintf("/repos/%s/%s/actions/jobs/%d/logs", url.PathEscape(owner), url.PathEscape(repoName), jobID)
+	body, status, err := c.apiGet(ctx, path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get action job log: %w", err)
+	}
+	switch status {
+	case http.StatusOK:
+		return string(body), nil
+	case http.StatusNotFound:
+		return "", fmt.Errorf("failed to get action job log: job %d not found in %s", jobID, repo)
+	default:
+		return "", fmt.Errorf("failed to get action job log: %s", apiErrorMessage(status, body))
+	}
+}
+
+// formatActionTime formats an Actions timestamp, leaving unset times empty
+func formatActionTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z")
+}
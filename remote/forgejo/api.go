@@ -0,0 +1,96 @@
+package forgejo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// apiGet performs an authenticated GET request against the Forgejo API for endpoints the SDK does not cover.
+// It returns the response body and status code; non-2xx statuses are not treated as errors.
+func (c *ForgejoClient) apiGet(ctx context.Context, path string, query url.Values) ([]byte, int, error) {
+	body, _, status, err := c.apiGetHeader(ctx, path, query)
+	return body, status, err
+}
+
+// apiGetHeader performs an apiGet request and also returns the response headers, such as the
+// X-Total-Count of list endpoints.
+func (c *ForgejoClient) apiGetHeader(ctx context.Context, path string, query url.Values) ([]byte, http.Header, int, error) {
+	return c.apiRequest(ctx, http.MethodGet, path, query, "", nil)
+}
+
+// apiGetJSON performs an apiGet request and decodes a 200 OK response into v, reporting the
+// message of any other status as an error
+func (c *ForgejoClient) apiGetJSON(ctx context.Context, path string, query url.Values, v any) error {
+	body, status, err := c.apiGet(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.New(apiErrorMessage(status, body))
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
+
+// apiPost performs an authenticated POST request with a JSON body against the Forgejo API for request
+// fields the SDK does not cover. It returns the response body and status code; non-2xx statuses are
+// not treated as errors.
+func (c *ForgejoClient) apiPost(ctx context.Context, path string, payload any) ([]byte, int, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, 0, err
+	}
+	body, _, status, err := c.apiRequest(ctx, http.MethodPost, path, nil, "application/json", bytes.NewReader(data))
+	return body, status, err
+}
+
+// apiRequest performs an authenticated request against the Forgejo API, sending body with the given
+// content type when it is not nil. It returns the response body, headers and status code; non-2xx
+// statuses are not treated as errors.
+func (c *ForgejoClient) apiRequest(ctx context.Context, method, path string, query url.Values, contentType string, body io.Reader) ([]byte, http.Header, int, error) {
+	endpoint := c.baseURL + "/api/v1" + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.Header, resp.StatusCode, err
+	}
+	return data, resp.Header, resp.StatusCode, nil
+}
+
+// apiErrorMessage extracts the "message" field of a Forgejo API error response
+func apiErrorMessage(status int, body []byte) string {
+	var apiErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		return apiErr.Message
+	}
+	return fmt.Sprintf("unexpected status %d", status)
+}
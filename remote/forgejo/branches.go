@@ -0,0 +1,250 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// ListBranches lists the branches of a repository with their last commit and protection status
+func (c *ForgejoClient) ListBranches(ctx context.Context, repo string, limit, offset int) (*remote.BranchList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := forgejo.ListRepoBranchesOptions{
+		ListOptions: forgejo.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Forgejo uses 1-based pagination
+		},
+	}
+
+	forgejoBranches, resp, err := c.client.ListRepoBranches(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	branches := make([]remote.Branch, 0, len(forgejoBranches))
+	for _, branch := range forgejoBranches {
+		if branch != nil {
+			branches = append(branches, convertBranch(branch))
+		}
+	}
+
+	// Forgejo reports the number of branches in X-Total-Count; fall back to the page size
+	total := offset + len(branches)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.BranchList{
+		Branches: branches,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// CreateBranch creates a branch from a branch, tag or commit SHA. An empty from creates the
+// branch from the default branch.
+func (c *ForgejoClient) CreateBranch(ctx context.Context, repo, name, from string) (*remote.Branch, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("branch name is required")
+	}
+
+	// The SDK only creates branches from other branches, old_ref_name also accepts tags and commits
+	payload := map[string]string{"new_branch_name": name}
+	if from != "" {
+		payload["old_ref_name"] = from
+	}
+	body, status, err := c.apiPost(ctx, fmt.Sprintf("/repos/%s/%s/branches", url.PathEscape(owner), url.PathEscape(repoName)), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create branch %s: %w", name, err)
+	}
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create branch %s: %s", name, apiErrorMessage(status, body))
+	}
+
+	var branch forgejo.Branch
+	if err := json.Unmarshal(body, &branch); err != nil {
+		return nil, fmt.Errorf("failed to decode branch: %w", err)
+	}
+	result := convertBranch(&branch)
+	return &result, nil
+}
+
+// DeleteBranch deletes a branch. The server refuses to delete the default branch and protected branches.
+func (c *ForgejoClient) DeleteBranch(ctx context.Context, repo, name string) error {
+	// Check if client is initialized
+	if c.client == nil {
+		return fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if name == "" {
+		return fmt.Errorf("branch name is required")
+	}
+
+	deleted, resp, err := c.client.DeleteRepoBranch(owner, repoName, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w", name, err)
+	}
+	if deleted {
+		return nil
+	}
+	// The SDK only reports the status of a failed delete, not the error message
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("failed to delete branch %s: branch not found", name)
+	case http.StatusForbidden:
+		return fmt.Errorf("failed to delete branch %s: the branch is protected or is the default branch", name)
+	default:
+		return fmt.Errorf("failed to delete branch %s: unexpected status %d", name, resp.StatusCode)
+	}
+}
+
+// CompareBranches compares two branches on the server, returning how far head is ahead of and
+// behind base with at most limit commits in each direction
+func (c *ForgejoClient) CompareBranches(ctx context.Context, repo, base, head string, limit int) (*remote.BranchComparison, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if base == "" || head == "" {
+		return nil, fmt.Errorf("base and head are required")
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	ahead, err := c.compareCommits(ctx, owner, repoName, base, head)
+	if err != nil {
+		return nil, err
+	}
+	behind, err := c.compareCommits(ctx, owner, repoName, head, base)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remote.BranchComparison{
+		Base:          base,
+		Head:          head,
+		AheadBy:       ahead.TotalCommits,
+		BehindBy:      behind.TotalCommits,
+		AheadCommits:  convertCommits(ahead.Commits, limit),
+		BehindCommits: convertCommits(behind.Commits, limit),
+	}, nil
+}
+
+// compareCommits lists the commits on head that are not on base. The compare endpoint is
+// requested directly, since the SDK refuses it on servers reporting a version before 1.22.
+func (c *ForgejoClient) compareCommits(ctx context.Context, owner, repoName, base, head string) (*forgejo.Compare, error) {
+	path := fmt.Sprintf("/repos/%s/%s/compare/%s...%s", url.PathEscape(owner), url.PathEscape(repoName), escapeRef(base), escapeRef(head))
+	body, status, err := c.apiGet(ctx, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %s...%s: %w", base, head, err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to compare %s...%s: %s", base, head, apiErrorMessage(status, body))
+	}
+
+	var compare forgejo.Compare
+	if err := json.Unmarshal(body, &compare); err != nil {
+		return nil, fmt.Errorf("failed to decode comparison: %w", err)
+	}
+	return &compare, nil
+}
+
+// convertBranch converts an SDK branch to the interface type
+func convertBranch(branch *forgejo.Branch) remote.Branch {
+	result := remote.Branch{
+		Name:              branch.Name,
+		Protected:         branch.Protected,
+		ProtectionRule:    branch.EffectiveBranchProtectionName,
+		RequiredApprovals: int(branch.RequiredApprovals),
+		UserCanPush:       branch.UserCanPush,
+		UserCanMerge:      branch.UserCanMerge,
+	}
+	if commit := branch.Commit; commit != nil {
+		result.Commit = remote.Commit{
+			SHA:     commit.ID,
+			Message: commit.Message,
+			URL:     commit.URL,
+		}
+		if commit.Author != nil {
+			result.Commit.Author = commit.Author.Name
+			result.Commit.AuthorEmail = commit.Author.Email
+		}
+		if !commit.Timestamp.IsZero() {
+			result.Commit.Date = commit.Timestamp.Format("2006-01-02T15:04:05Z")
+		}
+	}
+	return result
+}
+
+// convertCommits converts at most limit SDK commits to the interface type
+func convertCommits(forgejoCommits []*forgejo.Commit, limit int) []remote.Commit {
+	commits := make([]remote.Commit, 0, min(len(forgejoCommits), limit))
+	for _, commit := range forgejoCommits {
+		if len(commits) == limit {
+			break
+		}
+		if commit == nil || commit.CommitMeta == nil {
+			continue
+		}
+		commits = append(commits, convertCommit(commit))
+	}
+	return commits
+}
+
+// escapeRef escapes each path segment of a ref, keeping the slashes of names like feature/x
+func escapeRef(ref string) string {
+	segments := strings.Split(ref, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
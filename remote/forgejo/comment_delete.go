@@ -0,0 +1,69 @@
+package forgejo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// DeleteComment deletes an issue or pull request comment after checking that it belongs to the
+// given issue and, unless allowed otherwise, that the authenticated user wrote it
+func (c *ForgejoClient) DeleteComment(ctx context.Context, args remote.DeleteCommentArgs) error {
+	// Check if client is initialized
+	if c.client == nil {
+		return fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.CommentID <= 0 {
+		return fmt.Errorf("invalid comment ID: %d, must be positive", args.CommentID)
+	}
+	if args.IssueNumber <= 0 {
+		return fmt.Errorf("invalid issue number: %d, must be positive", args.IssueNumber)
+	}
+
+	comment, resp, err := c.client.GetIssueComment(owner, repoName, int64(args.CommentID))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("failed to delete comment: comment %d not found in %s", args.CommentID, args.Repository)
+		}
+		return fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	// The issue and pull request URLs are the only link from a comment to its issue,
+	// so a comment without them cannot be shown to belong to the requested one
+	suffix := "/" + strconv.Itoa(args.IssueNumber)
+	if !strings.HasSuffix(comment.IssueURL, suffix) && !strings.HasSuffix(comment.PRURL, suffix) {
+		return fmt.Errorf("failed to delete comment: comment %d does not belong to #%d", args.CommentID, args.IssueNumber)
+	}
+
+	if !args.AllowOthers {
+		me, _, err := c.client.GetMyUserInfo()
+		if err != nil {
+			return fmt.Errorf("failed to get authenticated user: %w", err)
+		}
+		author := ""
+		if comment.Poster != nil {
+			author = comment.Poster.UserName
+		}
+		if !strings.EqualFold(author, me.UserName) {
+			return fmt.Errorf("failed to delete comment: %w: comment %d was written by %s, not %s",
+				remote.ErrNotCommentAuthor, args.CommentID, author, me.UserName)
+		}
+	}
+
+	if _, err := c.client.DeleteIssueComment(owner, repoName, int64(args.CommentID)); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
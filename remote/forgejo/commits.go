@@ -0,0 +1,304 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// commitScanPageSize is the number of commits requested per page when filtering by author
+const commitScanPageSize = 50
+
+// maxAuthorScanCommits bounds the history searched for commits of an author
+const maxAuthorScanCommits = 1000
+
+// forgejoCommit is an SDK commit with the status of its changed files, which the SDK type leaves out
+type forgejoCommit struct {
+	forgejo.Commit
+	Files []struct {
+		Filename string `json:"filename"`
+		Status   string `json:"status"`
+	} `json:"files"`
+}
+
+// ListCommits lists the commits of a repository, newest first. The API cannot filter by author,
+// so for an author the most recent maxAuthorScanCommits commits are searched instead; the total
+// is then only known when the search reaches the end of the history.
+func (c *ForgejoClient) ListCommits(ctx context.Context, repo string, options remote.ListCommitsOptions) (*remote.CommitList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if options.Limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", options.Limit)
+	}
+
+	// The SDK has no since and until options, so the endpoint is requested directly
+	path := fmt.Sprintf("/repos/%s/%s/commits", url.PathEscape(owner), url.PathEscape(repoName))
+	query := url.Values{}
+	if options.Ref != "" {
+		query.Set("sha", options.Ref)
+	}
+	if options.Path != "" {
+		query.Set("path", options.Path)
+	}
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+	if !options.Until.IsZero() {
+		query.Set("until", options.Until.Format(time.RFC3339))
+	}
+	// Stats and files are costly to compute and only returned for single commits
+	query.Set("stat", "false")
+	query.Set("verification", "false")
+	query.Set("files", "false")
+
+	if options.Author == "" {
+		query.Set("limit", strconv.Itoa(options.Limit))
+		query.Set("page", strconv.Itoa(options.Offset/options.Limit+1)) // Forgejo uses 1-based pagination
+		commits, total, err := c.listCommitsPage(ctx, path, query)
+		if err != nil {
+			return nil, err
+		}
+		if total < 0 {
+			total = options.Offset + len(commits)
+		}
+		return &remote.CommitList{
+			Commits: commits,
+			Total:   &total,
+			Limit:   options.Limit,
+			Offset:  options.Offset,
+		}, nil
+	}
+
+	// The matching commits are only counted when the search reaches the end of the history
+	result := &remote.CommitList{Commits: []remote.Commit{}, Limit: options.Limit, Offset: options.Offset}
+	matched := 0
+	exhausted := false
+	query.Set("limit", strconv.Itoa(commitScanPageSize))
+	for page := 1; page <= maxAuthorScanCommits/commitScanPageSize && len(result.Commits) < options.Limit; page++ {
+		query.Set("page", strconv.Itoa(page))
+		commits, _, err := c.listCommitsPage(ctx, path, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, commit := range commits {
+			if !matchCommitAuthor(commit, options.Author) {
+				continue
+			}
+			if matched >= options.Offset && len(result.Commits) < options.Limit {
+				result.Commits = append(result.Commits, commit)
+			}
+			matched++
+		}
+		if len(commits) < commitScanPageSize {
+			exhausted = true
+			break
+		}
+	}
+	switch {
+	case exhausted:
+		result.Total = &matched
+	case len(result.Commits) < options.Limit:
+		// The page is short only because the search stopped at maxAuthorScanCommits
+		result.ScanLimited = true
+	}
+	return result, nil
+}
+
+// listCommitsPage requests a page of commits, returning them with the number of matching commits
+// that Forgejo reports in X-Total-Count, or -1 when the header is missing
+func (c *ForgejoClient) listCommitsPage(ctx context.Context, path string, query url.Values) ([]remote.Commit, int, error) {
+	body, header, status, err := c.apiGetHeader(ctx, path, query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list commits: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to list commits: %s", apiErrorMessage(status, body))
+	}
+
+	var forgejoCommits []*forgejo.Commit
+	if err := json.Unmarshal(body, &forgejoCommits); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode commits: %w", err)
+	}
+
+	total := -1
+	if count, err := strconv.Atoi(header.Get("X-Total-Count")); err == nil {
+		total = count
+	}
+	return convertCommits(forgejoCommits, len(forgejoCommits)), total, nil
+}
+
+// GetCommit gets a single commit with its parents, stats and changed files. The sha may also
+// be a branch or tag name.
+func (c *ForgejoClient) GetCommit(ctx context.Context, repo, sha string) (*remote.Commit, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if sha == "" {
+		return nil, fmt.Errorf("commit SHA is required")
+	}
+
+	// The SDK commit type has no file status, so the endpoint is requested directly
+	path := fmt.Sprintf("/repos/%s/%s/git/commits/%s", url.PathEscape(owner), url.PathEscape(repoName), url.PathEscape(sha))
+	body, status, err := c.apiGet(ctx, path, url.Values{"stat": {"true"}, "files": {"true"}, "verification": {"false"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", sha, err)
+	}
+	if status == http.StatusNotFound || status == http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf("commit %s not found", sha)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to get commit %s: %s", sha, apiErrorMessage(status, body))
+	}
+
+	var commit forgejoCommit
+	if err := json.Unmarshal(body, &commit); err != nil {
+		return nil, fmt.Errorf("failed to decode commit: %w", err)
+	}
+	if commit.CommitMeta == nil {
+		return nil, fmt.Errorf("commit %s not found", sha)
+	}
+
+	result := convertCommit(&commit.Commit)
+	for _, parent := range commit.Parents {
+		if parent != nil {
+			result.Parents = append(result.Parents, parent.SHA)
+		}
+	}
+	if commit.Stats != nil {
+		result.Stats = &remote.CommitStats{
+			Total:     commit.Stats.Total,
+			Additions: commit.Stats.Additions,
+			Deletions: commit.Stats.Deletions,
+		}
+	}
+	for _, file := range commit.Files {
+		result.Files = append(result.Files, remote.CommitFile{Filename: file.Filename, Status: file.Status})
+	}
+	return &result, nil
+}
+
+// GetCommitDiff fetches the unified diff of a single commit
+func (c *ForgejoClient) GetCommitDiff(ctx context.Context, repo, sha string) (string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if sha == "" {
+		return "", fmt.Errorf("commit SHA is required")
+	}
+
+	diff, _, err := c.client.GetCommitDiff(owner, repoName, sha)
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit diff: %w", err)
+	}
+
+	return string(diff), nil
+}
+
+// ListPullRequestCommits lists the commits of a pull request
+func (c *ForgejoClient) ListPullRequestCommits(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*remote.CommitList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := forgejo.ListPullRequestCommitsOptions{
+		ListOptions: forgejo.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Forgejo uses 1-based pagination
+		},
+	}
+
+	forgejoCommits, resp, err := c.client.ListPullRequestCommits(owner, repoName, int64(pullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request commits: %w", err)
+	}
+	commits := convertCommits(forgejoCommits, len(forgejoCommits))
+
+	// Forgejo reports the number of commits in X-Total-Count; fall back to the page size
+	total := offset + len(commits)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.CommitList{
+		Commits: commits,
+		Total:   &total,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// matchCommitAuthor reports whether the author name, email or username of a commit is author
+func matchCommitAuthor(commit remote.Commit, author string) bool {
+	return strings.EqualFold(commit.Author, author) ||
+		strings.EqualFold(commit.AuthorEmail, author) ||
+		(commit.AuthorLogin != "" && strings.EqualFold(commit.AuthorLogin, author))
+}
+
+// convertCommit converts an SDK commit to the interface type
+func convertCommit(commit *forgejo.Commit) remote.Commit {
+	result := remote.Commit{URL: commit.HTMLURL}
+	if commit.CommitMeta != nil {
+		result.SHA = commit.SHA
+	}
+	if commit.RepoCommit != nil {
+		result.Message = commit.RepoCommit.Message
+		if commit.RepoCommit.Author != nil {
+			result.Author = commit.RepoCommit.Author.Name
+			result.AuthorEmail = commit.RepoCommit.Author.Email
+			result.Date = commit.RepoCommit.Author.Date
+		}
+	}
+	if commit.Author != nil {
+		result.AuthorLogin = commit.Author.UserName
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package forgejo
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 	"github.com/kunde21/forgejo-mcp/remote"
@@ -11,6 +12,11 @@ import (
 // ForgejoClient implements the ClientInterface using the Forgejo SDK
 type ForgejoClient struct {
 	client *forgejo.Client
+
+	// Used for API endpoints the SDK does not cover yet, such as Actions runs
+	baseURL    string
+	token      string
+	httpClient *http.Client
 }
 
 // NewForgejoClient creates a new Forgejo client
@@ -33,8 +39,15 @@ func NewForgejoClientWithHTTPClient(url, token string, httpClient *http.Client)
 		return nil, fmt.Errorf("failed to create Forgejo client: %w", err)
 	}
 
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
 	return &ForgejoClient{
-		client: client,
+		client:     client,
+		baseURL:    strings.TrimSuffix(url, "/"),
+		token:      token,
+		httpClient: httpClient,
 	}, nil
 }
 
@@ -2,15 +2,21 @@ package forgejo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 	"github.com/kunde21/forgejo-mcp/remote"
 )
 
-// ListIssues retrieves issues from the specified repository
-func (c *ForgejoClient) ListIssues(ctx context.Context, repo string, limit, offset int) ([]remote.Issue, error) {
+// ListIssues retrieves issues from the specified repository matching the given filters.
+// The SDK has no sort option, so the issues endpoint is queried directly.
+func (c *ForgejoClient) ListIssues(ctx context.Context, repo string, options remote.ListIssuesOptions) ([]remote.Issue, error) {
 	// Check if client is initialized
 	if c.client == nil {
 		return nil, fmt.Errorf("client not initialized")
@@ -22,50 +28,177 @@ func (c *ForgejoClient) ListIssues(ctx context.Context, repo string, limit, offs
 		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
 	}
 
-	// List issues using Forgejo SDK
-	pageSize := limit
+	pageSize := options.Limit
 	if pageSize <= 0 {
 		pageSize = 10 // Default page size
 	}
 
 	page := 1
-	if limit > 0 {
-		page = offset/limit + 1 // Forgejo uses 1-based pagination
+	if options.Limit > 0 {
+		page = options.Offset/options.Limit + 1 // Forgejo uses 1-based pagination
 	}
 
-	opts := forgejo.ListIssueOption{
-		ListOptions: forgejo.ListOptions{
-			PageSize: pageSize,
-			Page:     page,
-		},
-		State: forgejo.StateOpen, // Only open issues for now
+	state := options.State
+	if state == "" {
+		state = string(forgejo.StateOpen)
 	}
 
-	forgejoIssues, _, err := c.client.ListRepoIssues(owner, repoName, opts)
+	query := url.Values{}
+	query.Set("state", state)
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(pageSize))
+	if options.Type != "" {
+		query.Set("type", options.Type)
+	}
+	if len(options.Labels) > 0 {
+		query.Set("labels", strings.Join(options.Labels, ","))
+	}
+	if options.Milestone != "" {
+		query.Set("milestones", options.Milestone)
+	}
+	if options.Query != "" {
+		query.Set("q", options.Query)
+	}
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+	if !options.Before.IsZero() {
+		query.Set("before", options.Before.Format(time.RFC3339))
+	}
+	if options.Author != "" {
+		query.Set("created_by", options.Author)
+	}
+	if options.Assignee != "" {
+		query.Set("assigned_by", options.Assignee)
+	}
+	if options.Mentioned != "" {
+		query.Set("mentioned_by", options.Mentioned)
+	}
+	if options.Sort != "" {
+		query.Set("sort", options.Sort)
+	}
+
+	path := fmt.Sprintf("/repos/%s/%s/issues", url.PathEscape(owner), url.PathEscape(repoName))
+	body, status, err := c.apiGet(ctx, path, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list issues: %w", err)
 	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to list issues: %s", apiErrorMessage(status, body))
+	}
+
+	var forgejoIssues []*forgejo.Issue
+	if err := json.Unmarshal(body, &forgejoIssues); err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
 
 	// Convert to our Issue struct
 	issues := make([]remote.Issue, len(forgejoIssues))
 	for i, gi := range forgejoIssues {
-		author := "unknown"
-		if gi.Poster != nil {
-			author = gi.Poster.UserName
-		}
+		issues[i] = convertIssue(gi)
+	}
+
+	return issues, nil
+}
+
+// SearchIssues searches issues and pull requests across all repositories visible to the
+// authenticated user. The SDK does not expose the involvement filters, so the search
+// endpoint is queried directly.
+func (c *ForgejoClient) SearchIssues(ctx context.Context, options remote.SearchIssuesOptions) ([]remote.Issue, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	pageSize := options.Limit
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+
+	state := options.State
+	if state == "" {
+		state = string(forgejo.StateOpen)
+	}
 
-		issues[i] = remote.Issue{
-			ID:     int(gi.ID),
-			Number: int(gi.Index),
-			Title:  gi.Title,
-			State:  string(gi.State),
-			User:   author,
+	query := url.Values{}
+	query.Set("state", state)
+	query.Set("page", strconv.Itoa(options.Offset/pageSize+1)) // Forgejo uses 1-based pagination
+	query.Set("limit", strconv.Itoa(pageSize))
+	if options.Type != "" {
+		query.Set("type", options.Type)
+	}
+	if options.Owner != "" {
+		query.Set("owner", options.Owner)
+	}
+	if options.Team != "" {
+		query.Set("team", options.Team)
+	}
+	if options.Involvement != "" {
+		query.Set(options.Involvement, "true")
+	}
+	if len(options.Labels) > 0 {
+		query.Set("labels", strings.Join(options.Labels, ","))
+	}
+	if options.Milestone != "" {
+		query.Set("milestones", options.Milestone)
+	}
+	if options.Query != "" {
+		query.Set("q", options.Query)
+	}
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+	if !options.Before.IsZero() {
+		query.Set("before", options.Before.Format(time.RFC3339))
+	}
+
+	body, status, err := c.apiGet(ctx, "/repos/issues/search", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to search issues: %s", apiErrorMessage(status, body))
+	}
+
+	var forgejoIssues []*forgejo.Issue
+	if err := json.Unmarshal(body, &forgejoIssues); err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues := make([]remote.Issue, len(forgejoIssues))
+	for i, gi := range forgejoIssues {
+		issues[i] = convertIssue(gi)
+		if gi.Repository != nil {
+			issues[i].Repository = gi.Repository.FullName
 		}
 	}
 
 	return issues, nil
 }
 
+// convertIssue converts an SDK issue from a list or search response to our Issue struct
+func convertIssue(gi *forgejo.Issue) remote.Issue {
+	author := "unknown"
+	if gi.Poster != nil {
+		author = gi.Poster.UserName
+	}
+
+	return remote.Issue{
+		ID:          int(gi.ID),
+		Number:      int(gi.Index),
+		Title:       gi.Title,
+		State:       string(gi.State),
+		Body:        gi.Body,
+		User:        author,
+		Created:     gi.Created.Format("2006-01-02T15:04:05Z"),
+		Updated:     gi.Updated.Format("2006-01-02T15:04:05Z"),
+		Labels:      convertLabels(gi.Labels),
+		Milestone:   convertMilestone(gi.Milestone),
+		Assignees:   convertUserNames(gi.Assignees),
+		PullRequest: gi.PullRequest != nil,
+	}
+}
+
 // CreateIssueComment creates a comment on an issue
 func (c *ForgejoClient) CreateIssueComment(ctx context.Context, repo string, issueNumber int, comment string) (*remote.Comment, error) {
 	// Check if client is initialized
@@ -174,6 +307,8 @@ func (c *ForgejoClient) ListIssueComments(ctx context.Context, repo string, issu
 		}
 	}
 
+	c.attachCommentReactions(ctx, owner, repoName, comments)
+
 	// Create IssueCommentList with pagination metadata
 	// Note: Forgejo SDK doesn't provide total count in ListIssueComments response
 	// We return the actual number of comments returned as total
@@ -258,10 +393,15 @@ func (c *ForgejoClient) CreateIssue(ctx context.Context, args remote.CreateIssue
 		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
 	}
 
+	if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
 	// Create issue using Forgejo SDK
 	opts := forgejo.CreateIssueOption{
-		Title: args.Title,
-		Body:  args.Body,
+		Title:     args.Title,
+		Body:      args.Body,
+		Assignees: args.Assignees,
 	}
 
 	forgejoIssue, _, err := c.client.CreateIssue(owner, repoName, opts)
@@ -276,31 +416,14 @@ func (c *ForgejoClient) CreateIssue(ctx context.Context, args remote.CreateIssue
 	}
 
 	issue := &remote.Issue{
-		ID:     int(forgejoIssue.ID),
-		Number: int(forgejoIssue.Index),
-		Title:  forgejoIssue.Title,
-		State:  string(forgejoIssue.State),
-		User:   author,
-	}
-
-	return issue, nil
-}
-
-// CreateIssueWithAttachments creates a new issue with attachments
-// Note: Attachment upload is not yet implemented as Forgejo/Gitea SDKs don't expose
-// issue attachment APIs. This method currently only creates the issue.
-func (c *ForgejoClient) CreateIssueWithAttachments(ctx context.Context, args remote.CreateIssueWithAttachmentsArgs) (*remote.Issue, error) {
-	// First create the issue
-	issue, err := c.CreateIssue(ctx, args.CreateIssueArgs)
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO: Implement attachment upload once Forgejo/Gitea attachment APIs are documented
-	// For now, we create the issue but ignore attachments
-	if len(args.Attachments) > 0 {
-		// Log that attachments were provided but not uploaded
-		// In a real implementation, this would upload each attachment
+		ID:        int(forgejoIssue.ID),
+		Number:    int(forgejoIssue.Index),
+		Title:     forgejoIssue.Title,
+		State:     string(forgejoIssue.State),
+		User:      author,
+		Labels:    convertLabels(forgejoIssue.Labels),
+		Milestone: convertMilestone(forgejoIssue.Milestone),
+		Assignees: convertUserNames(forgejoIssue.Assignees),
 	}
 
 	return issue, nil
@@ -352,6 +475,23 @@ func (c *ForgejoClient) EditIssue(ctx context.Context, args remote.EditIssueArgs
 		hasChanges = true
 	}
 
+	if args.Milestone != nil {
+		id, err := c.resolveMilestoneID(owner, repoName, *args.Milestone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit issue: %w", err)
+		}
+		editOptions.Milestone = &id
+		hasChanges = true
+	}
+
+	if args.Assignees != nil {
+		if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+			return nil, fmt.Errorf("failed to edit issue: %w", err)
+		}
+		editOptions.Assignees = args.Assignees
+		hasChanges = true
+	}
+
 	if !hasChanges {
 		return nil, fmt.Errorf("no changes specified")
 	}
@@ -369,14 +509,17 @@ func (c *ForgejoClient) EditIssue(ctx context.Context, args remote.EditIssueArgs
 	}
 
 	issue := &remote.Issue{
-		ID:      int(forgejoIssue.ID),
-		Number:  int(forgejoIssue.Index),
-		Title:   forgejoIssue.Title,
-		State:   string(forgejoIssue.State),
-		Body:    forgejoIssue.Body,
-		User:    author,
-		Updated: forgejoIssue.Updated.Format("2006-01-02T15:04:05Z07:00"),
-		Created: forgejoIssue.Created.Format("2006-01-02T15:04:05Z07:00"),
+		ID:        int(forgejoIssue.ID),
+		Number:    int(forgejoIssue.Index),
+		Title:     forgejoIssue.Title,
+		State:     string(forgejoIssue.State),
+		Body:      forgejoIssue.Body,
+		User:      author,
+		Updated:   forgejoIssue.Updated.Format("2006-01-02T15:04:05Z07:00"),
+		Created:   forgejoIssue.Created.Format("2006-01-02T15:04:05Z07:00"),
+		Labels:    convertLabels(forgejoIssue.Labels),
+		Milestone: convertMilestone(forgejoIssue.Milestone),
+		Assignees: convertUserNames(forgejoIssue.Assignees),
 	}
 
 	return issue, nil
@@ -21,7 +21,7 @@ func (c *ForgejoClient) ListNotifications(ctx context.Context, repo string, stat
 	case "unread":
 		sdkStatus = []forgejo.NotifyStatus{forgejo.NotifyStatusUnread}
 	default:
-		sdkStatus = []forgejo.NotifyStatus{forgejo.NotifyStatusRead, forgejo.NotifyStatusUnread}
+		sdkStatus = []forgejo.NotifyStatus{forgejo.NotifyStatusRead, forgejo.NotifyStatusUnread, forgejo.NotifyStatusPinned}
 	}
 
 	// Fetch all notifications (no repository filtering in SDK)
@@ -73,11 +73,119 @@ func (c *ForgejoClient) ListNotifications(ctx context.Context, repo string, stat
 	}, nil
 }
 
+// MarkNotification sets the status of a notification thread to read, unread or pinned
+func (c *ForgejoClient) MarkNotification(ctx context.Context, id int, status string) (*remote.Notification, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid notification ID: %d, must be positive", id)
+	}
+
+	var sdkStatus forgejo.NotifyStatus
+	switch status {
+	case remote.NotificationStatusRead:
+		sdkStatus = forgejo.NotifyStatusRead
+	case remote.NotificationStatusUnread:
+		sdkStatus = forgejo.NotifyStatusUnread
+	case remote.NotificationStatusPinned:
+		sdkStatus = forgejo.NotifyStatusPinned
+	default:
+		return nil, fmt.Errorf("invalid notification status: %s", status)
+	}
+
+	thread, _, err := c.client.ReadNotification(int64(id), sdkStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark notification: %w", err)
+	}
+
+	// Servers before 1.16 do not return the updated thread
+	if thread == nil {
+		return &remote.Notification{ID: id, Unread: sdkStatus == forgejo.NotifyStatusUnread, Pinned: sdkStatus == forgejo.NotifyStatusPinned}, nil
+	}
+	notification := convertToNotification(thread)
+	return &notification, nil
+}
+
+// MarkRepositoryNotificationsRead marks all unread notifications of a repository as read
+func (c *ForgejoClient) MarkRepositoryNotificationsRead(ctx context.Context, repo string) ([]remote.Notification, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	threads, _, err := c.client.ReadRepoNotifications(owner, repoName, forgejo.MarkNotificationOptions{
+		Status:   []forgejo.NotifyStatus{forgejo.NotifyStatusUnread},
+		ToStatus: forgejo.NotifyStatusRead,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark repository notifications: %w", err)
+	}
+
+	notifications := make([]remote.Notification, 0, len(threads))
+	for _, thread := range threads {
+		notifications = append(notifications, convertToNotification(thread))
+	}
+	return notifications, nil
+}
+
+// SubscribeIssue subscribes the authenticated user to an issue or pull request thread
+func (c *ForgejoClient) SubscribeIssue(ctx context.Context, repo string, number int) error {
+	owner, repoName, err := c.subscriptionTarget(repo, number)
+	if err != nil {
+		return err
+	}
+	if _, err := c.client.IssueSubscribe(owner, repoName, int64(number)); err != nil {
+		return fmt.Errorf("failed to subscribe to issue: %w", err)
+	}
+	return nil
+}
+
+// UnsubscribeIssue unsubscribes the authenticated user from an issue or pull request thread
+func (c *ForgejoClient) UnsubscribeIssue(ctx context.Context, repo string, number int) error {
+	owner, repoName, err := c.subscriptionTarget(repo, number)
+	if err != nil {
+		return err
+	}
+	if _, err := c.client.IssueUnSubscribe(owner, repoName, int64(number)); err != nil {
+		return fmt.Errorf("failed to unsubscribe from issue: %w", err)
+	}
+	return nil
+}
+
+// subscriptionTarget checks the client and arguments and returns the repository owner and name
+func (c *ForgejoClient) subscriptionTarget(repo string, number int) (string, string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if number <= 0 {
+		return "", "", fmt.Errorf("invalid issue number: %d, must be positive", number)
+	}
+	return owner, repoName, nil
+}
+
 // convertToNotification converts SDK notification to interface type with URL parsing
 func convertToNotification(thread *forgejo.NotificationThread) remote.Notification {
 	notification := remote.Notification{
 		ID:      int(thread.ID),
 		Unread:  thread.Unread,
+		Pinned:  thread.Pinned,
 		Updated: thread.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 
@@ -3,6 +3,8 @@ package forgejo
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
@@ -179,6 +181,8 @@ func (c *ForgejoClient) ListPullRequestComments(ctx context.Context, repo string
 		}
 	}
 
+	c.attachCommentReactions(ctx, owner, repoName, comments)
+
 	// Create PullRequestCommentList with pagination metadata
 	// Note: Forgejo SDK doesn't provide total count in ListIssueComments response
 	// We return the actual number of comments returned as total
@@ -357,14 +361,39 @@ func (c *ForgejoClient) EditPullRequest(ctx context.Context, args remote.EditPul
 		hasChanges = true
 	}
 
-	if !hasChanges {
+	if args.Assignees != nil {
+		if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+		editOptions.Assignees = args.Assignees
+		hasChanges = true
+	}
+
+	if !hasChanges && args.Milestone == nil {
 		return nil, fmt.Errorf("no changes specified for pull request edit")
 	}
 
-	// Edit pull request using Forgejo SDK
-	forgejoPR, _, err := c.client.EditPullRequest(owner, repoName, int64(args.PullRequestNumber), editOptions)
-	if err != nil {
-		return nil, fmt.Errorf("failed to edit pull request: %w", err)
+	// The pull request endpoint cannot clear a milestone, so it is set through the issue endpoint
+	if args.Milestone != nil {
+		if err := c.setIssueMilestone(owner, repoName, args.PullRequestNumber, *args.Milestone); err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+	}
+
+	var forgejoPR *forgejo.PullRequest
+	var err error
+	if hasChanges {
+		// Edit pull request using Forgejo SDK
+		forgejoPR, _, err = c.client.EditPullRequest(owner, repoName, int64(args.PullRequestNumber), editOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+	} else {
+		// Only the milestone changed; fetch the updated pull request
+		forgejoPR, _, err = c.client.GetPullRequest(owner, repoName, int64(args.PullRequestNumber))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", err)
+		}
 	}
 
 	// Convert to our PullRequest struct
@@ -412,6 +441,8 @@ func (c *ForgejoClient) EditPullRequest(ctx context.Context, args remote.EditPul
 		UpdatedAt: updatedAt,
 		Head:      head,
 		Base:      base,
+		Milestone: convertMilestone(forgejoPR.Milestone),
+		Assignees: convertUserNames(forgejoPR.Assignees),
 	}, nil
 }
 
@@ -433,12 +464,21 @@ func (c *ForgejoClient) CreatePullRequest(ctx context.Context, args remote.Creat
 		title = "[DRAFT] " + title
 	}
 
+	// Merge the single assignee into the assignee list
+	assignees := args.Assignees
+	if args.Assignee != "" && !slices.Contains(assignees, args.Assignee) {
+		assignees = append([]string{args.Assignee}, assignees...)
+	}
+	if err := c.validateUserAccess(ctx, owner, repoName, assignees, "write"); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
 	opts := forgejo.CreatePullRequestOption{
-		Head:     args.Head,
-		Base:     args.Base,
-		Title:    title,
-		Body:     args.Body,
-		Assignee: args.Assignee,
+		Head:      args.Head,
+		Base:      args.Base,
+		Title:     title,
+		Body:      args.Body,
+		Assignees: assignees,
 	}
 
 	fpr, _, err := c.client.CreatePullRequest(owner, repoName, opts)
@@ -491,6 +531,7 @@ func (c *ForgejoClient) CreatePullRequest(ctx context.Context, args remote.Creat
 		UpdatedAt: updatedAt,
 		Head:      head,
 		Base:      base,
+		Assignees: convertUserNames(fpr.Assignees),
 	}, nil
 }
 
@@ -521,6 +562,58 @@ func (c *ForgejoClient) GetPullRequest(ctx context.Context, repo string, number
 	return c.convertToPullRequestDetails(forgejoPR), nil
 }
 
+// MergePullRequest merges a pull request, or schedules the merge for when all status checks succeed
+func (c *ForgejoClient) MergePullRequest(ctx context.Context, args remote.MergePullRequestArgs) (*remote.MergeResult, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	style := args.Style
+	if style == "" {
+		style = remote.MergeStyleMerge
+	}
+
+	opts := forgejo.MergePullRequestOption{
+		Style:                  forgejo.MergeStyle(style),
+		Title:                  args.Title,
+		Message:                args.Message,
+		DeleteBranchAfterMerge: args.DeleteBranchAfterMerge,
+		MergeWhenChecksSucceed: args.MergeWhenChecksSucceed,
+	}
+
+	// The SDK reports non-2xx responses through the status code rather than an error
+	_, resp, err := c.client.MergePullRequest(owner, repoName, int64(args.PullRequestNumber), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &remote.MergeResult{Merged: true, Style: style}, nil
+	case http.StatusCreated:
+		return &remote.MergeResult{Scheduled: true, Style: style}, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("pull request #%d not found in %s", args.PullRequestNumber, args.Repository)
+	case http.StatusMethodNotAllowed:
+		return nil, fmt.Errorf("pull request #%d is not mergeable (already merged, closed, conflicting, or blocked by branch protection)", args.PullRequestNumber)
+	case http.StatusConflict:
+		return nil, fmt.Errorf("merge conflict or %s merge not possible", style)
+	default:
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+}
+
 // convertToPullRequestDetails converts Forgejo PR to our detailed format
 func (c *ForgejoClient) convertToPullRequestDetails(fpr *forgejo.PullRequest) *remote.PullRequestDetails {
 	// Extract user information
@@ -593,19 +686,6 @@ func (c *ForgejoClient) convertToPullRequestDetails(fpr *forgejo.PullRequest) *r
 		}
 	}
 
-	// Convert milestone
-	var milestone *remote.Milestone
-	if fpr.Milestone != nil {
-		milestone = &remote.Milestone{
-			ID:           int(fpr.Milestone.ID),
-			Title:        fpr.Milestone.Title,
-			Description:  fpr.Milestone.Description,
-			State:        string(fpr.Milestone.State),
-			OpenIssues:   int(fpr.Milestone.OpenIssues),
-			ClosedIssues: int(fpr.Milestone.ClosedIssues),
-		}
-	}
-
 	// Convert head branch
 	var head remote.PullRequestBranch
 	if fpr.Head != nil {
@@ -639,7 +719,7 @@ func (c *ForgejoClient) convertToPullRequestDetails(fpr *forgejo.PullRequest) *r
 		DiffURL:             fpr.DiffURL,
 		PatchURL:            fpr.PatchURL,
 		Labels:              labels,
-		Milestone:           milestone,
+		Milestone:           convertMilestone(fpr.Milestone),
 		Assignee:            assignee,
 		Assignees:           assignees,
 		Comments:            fpr.Comments,
@@ -0,0 +1,179 @@
+package forgejo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// CreatePullRequestReview submits a review with optional inline comments on a pull request
+func (c *ForgejoClient) CreatePullRequestReview(ctx context.Context, args remote.CreatePullRequestReviewArgs) (*remote.PullRequestReview, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	comments := make([]forgejo.CreatePullReviewComment, len(args.Comments))
+	for i, comment := range args.Comments {
+		comments[i] = forgejo.CreatePullReviewComment{
+			Path:       comment.Path,
+			Body:       comment.Body,
+			NewLineNum: int64(comment.NewLine),
+			OldLineNum: int64(comment.OldLine),
+		}
+	}
+
+	opts := forgejo.CreatePullReviewOptions{
+		State:    forgejo.ReviewStateType(args.State),
+		Body:     args.Body,
+		CommitID: args.CommitID,
+		Comments: comments,
+	}
+
+	review, _, err := c.client.CreatePullReview(owner, repoName, int64(args.PullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request review: %w", err)
+	}
+
+	result := convertPullReview(review)
+	return &result, nil
+}
+
+// ListPullRequestReviews lists the reviews submitted on a pull request
+func (c *ForgejoClient) ListPullRequestReviews(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*remote.PullRequestReviewList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := forgejo.ListPullReviewsOptions{
+		ListOptions: forgejo.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Forgejo uses 1-based pagination
+		},
+	}
+
+	forgejoReviews, _, err := c.client.ListPullReviews(owner, repoName, int64(pullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request reviews: %w", err)
+	}
+
+	reviews := make([]remote.PullRequestReview, len(forgejoReviews))
+	for i, review := range forgejoReviews {
+		reviews[i] = convertPullReview(review)
+	}
+
+	// Note: Forgejo SDK doesn't provide total count in ListPullReviews response
+	return &remote.PullRequestReviewList{
+		Reviews: reviews,
+		Total:   len(reviews),
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// ListPullRequestReviewComments lists the inline comments attached to a pull request review
+func (c *ForgejoClient) ListPullRequestReviewComments(ctx context.Context, repo string, pullRequestNumber, reviewID int) ([]remote.PullRequestReviewComment, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if reviewID <= 0 {
+		return nil, fmt.Errorf("invalid review ID: %d, must be positive", reviewID)
+	}
+
+	forgejoComments, _, err := c.client.ListPullReviewComments(owner, repoName, int64(pullRequestNumber), int64(reviewID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request review comments: %w", err)
+	}
+
+	comments := make([]remote.PullRequestReviewComment, len(forgejoComments))
+	for i, fc := range forgejoComments {
+		author := "unknown"
+		if fc.Reviewer != nil {
+			author = fc.Reviewer.UserName
+		}
+		comments[i] = remote.PullRequestReviewComment{
+			ID:       int(fc.ID),
+			ReviewID: int(fc.ReviewID),
+			Body:     fc.Body,
+			Author:   author,
+			Path:     fc.Path,
+			Line:     int(fc.LineNum),
+			OldLine:  int(fc.OldLineNum),
+			CommitID: fc.CommitID,
+			DiffHunk: fc.DiffHunk,
+			Resolved: fc.Resolver != nil,
+			Created:  fc.Created.Format("2006-01-02T15:04:05Z"),
+			Updated:  fc.Updated.Format("2006-01-02T15:04:05Z"),
+			HTMLURL:  fc.HTMLURL,
+		}
+	}
+
+	return comments, nil
+}
+
+// convertPullReview converts a Forgejo review to our PullRequestReview struct
+func convertPullReview(review *forgejo.PullReview) remote.PullRequestReview {
+	reviewer := "unknown"
+	if review.Reviewer != nil {
+		reviewer = review.Reviewer.UserName
+	} else if review.ReviewerTeam != nil {
+		reviewer = review.ReviewerTeam.Name
+	}
+
+	submitted := ""
+	if !review.Submitted.IsZero() {
+		submitted = review.Submitted.Format("2006-01-02T15:04:05Z")
+	}
+
+	return remote.PullRequestReview{
+		ID:            int(review.ID),
+		Reviewer:      reviewer,
+		State:         string(review.State),
+		Body:          review.Body,
+		CommitID:      review.CommitID,
+		Stale:         review.Stale,
+		Official:      review.Official,
+		Dismissed:     review.Dismissed,
+		CommentsCount: review.CodeCommentsCount,
+		Submitted:     submitted,
+		HTMLURL:       review.HTMLURL,
+	}
+}
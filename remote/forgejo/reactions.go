@@ -0,0 +1,153 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// ListReactions lists the reactions on an issue or pull request body, or on a comment
+func (c *ForgejoClient) ListReactions(ctx context.Context, target remote.IssueOrCommentTarget) ([]remote.Reaction, error) {
+	owner, repoName, err := c.issueOrCommentTarget(target)
+	if err != nil {
+		return nil, err
+	}
+
+	var reactions []*forgejo.Reaction
+	if target.CommentID > 0 {
+		reactions, _, err = c.client.GetIssueCommentReactions(owner, repoName, int64(target.CommentID))
+	} else {
+		reactions, _, err = c.client.GetIssueReactions(owner, repoName, int64(target.IssueNumber))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reactions: %w", err)
+	}
+
+	result := make([]remote.Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		if r != nil {
+			result = append(result, convertReaction(r))
+		}
+	}
+	return result, nil
+}
+
+// AddReaction adds a reaction as the authenticated user to an issue or pull request body, or to a comment
+func (c *ForgejoClient) AddReaction(ctx context.Context, target remote.IssueOrCommentTarget, content string) (*remote.Reaction, error) {
+	owner, repoName, err := c.issueOrCommentTarget(target)
+	if err != nil {
+		return nil, err
+	}
+
+	var reaction *forgejo.Reaction
+	if target.CommentID > 0 {
+		reaction, _, err = c.client.PostIssueCommentReaction(owner, repoName, int64(target.CommentID), content)
+	} else {
+		reaction, _, err = c.client.PostIssueReaction(owner, repoName, int64(target.IssueNumber), content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	result := convertReaction(reaction)
+	return &result, nil
+}
+
+// RemoveReaction removes a reaction of the authenticated user from an issue or pull request body, or from a comment
+func (c *ForgejoClient) RemoveReaction(ctx context.Context, target remote.IssueOrCommentTarget, content string) error {
+	owner, repoName, err := c.issueOrCommentTarget(target)
+	if err != nil {
+		return err
+	}
+
+	if target.CommentID > 0 {
+		_, err = c.client.DeleteIssueCommentReaction(owner, repoName, int64(target.CommentID), content)
+	} else {
+		_, err = c.client.DeleteIssueReaction(owner, repoName, int64(target.IssueNumber), content)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	return nil
+}
+
+// issueOrCommentTarget checks the client and target and returns the repository owner and name
+func (c *ForgejoClient) issueOrCommentTarget(target remote.IssueOrCommentTarget) (string, string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(target.Repository, "/")
+	if !ok {
+		return "", "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", target.Repository)
+	}
+
+	if (target.IssueNumber > 0) == (target.CommentID > 0) {
+		return "", "", fmt.Errorf("exactly one of issue number or comment ID must be positive")
+	}
+	return owner, repoName, nil
+}
+
+// commentReactionWorkers bounds the concurrent requests made to load the reactions of listed comments
+const commentReactionWorkers = 4
+
+// attachCommentReactions adds a reaction summary to each comment, loading them with a bounded
+// number of concurrent requests. Reactions are supplementary, so a comment whose reactions fail
+// to load is left without a summary instead of failing the listing.
+func (c *ForgejoClient) attachCommentReactions(ctx context.Context, owner, repo string, comments []remote.Comment) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, commentReactionWorkers)
+	for i := range comments {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			if reactions, err := c.commentReactions(ctx, owner, repo, comments[i].ID); err == nil {
+				comments[i].Reactions = summarizeReactions(reactions)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// commentReactions fetches the reactions on a comment through the raw API, which unlike the SDK honours ctx
+func (c *ForgejoClient) commentReactions(ctx context.Context, owner, repo string, commentID int) ([]*forgejo.Reaction, error) {
+	path := fmt.Sprintf("/repos/%s/%s/issues/comments/%d/reactions", url.PathEscape(owner), url.PathEscape(repo), commentID)
+	body, status, err := c.apiGet(ctx, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reactions of comment %d: %w", commentID, err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to load reactions of comment %d: %s", commentID, apiErrorMessage(status, body))
+	}
+	var reactions []*forgejo.Reaction
+	if err := json.Unmarshal(body, &reactions); err != nil {
+		return nil, fmt.Errorf("failed to load reactions of comment %d: invalid response: %w", commentID, err)
+	}
+	return reactions, nil
+}
+
+// convertReaction converts a Forgejo reaction to our format
+func convertReaction(r *forgejo.Reaction) remote.Reaction {
+	reaction := remote.Reaction{Content: r.Reaction}
+	if r.User != nil {
+		reaction.User = r.User.UserName
+	}
+	if !r.Created.IsZero() {
+		reaction.Created = r.Created.Format("2006-01-02T15:04:05Z")
+	}
+	return reaction
+}
@@ -0,0 +1,233 @@
+package forgejo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// ListReleases lists the releases of a repository, newest first, including drafts the user can see
+func (c *ForgejoClient) ListReleases(ctx context.Context, repo string, limit, offset int) (*remote.ReleaseList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := forgejo.ListReleasesOptions{
+		ListOptions: forgejo.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Forgejo uses 1-based pagination
+		},
+	}
+
+	forgejoReleases, resp, err := c.client.ListReleases(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+
+	releases := make([]remote.Release, 0, len(forgejoReleases))
+	for _, release := range forgejoReleases {
+		if release != nil {
+			releases = append(releases, *convertRelease(release))
+		}
+	}
+
+	// Forgejo reports the number of releases in X-Total-Count; fall back to the page size
+	total := offset + len(releases)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.ReleaseList{
+		Releases: releases,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// GetRelease gets the release of a tag with its assets
+func (c *ForgejoClient) GetRelease(ctx context.Context, repo, tag string) (*remote.Release, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	release, err := c.getReleaseByTag(owner, repoName, tag)
+	if err != nil {
+		return nil, err
+	}
+	return convertRelease(release), nil
+}
+
+// CreateRelease creates a release, creating its tag from the target when the tag does not exist
+func (c *ForgejoClient) CreateRelease(ctx context.Context, args remote.CreateReleaseArgs) (*remote.Release, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	release, _, err := c.client.CreateRelease(owner, repoName, forgejo.CreateReleaseOption{
+		TagName:      args.TagName,
+		Target:       args.Target,
+		Title:        args.Name,
+		Note:         args.Body,
+		IsDraft:      args.Draft,
+		IsPrerelease: args.Prerelease,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release: %w", err)
+	}
+
+	return convertRelease(release), nil
+}
+
+// EditRelease updates the tag, target, name, notes, or draft and prerelease flags of the release of a tag
+func (c *ForgejoClient) EditRelease(ctx context.Context, args remote.EditReleaseArgs) (*remote.Release, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	current, err := c.getReleaseByTag(owner, repoName, args.TagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit release: %w", err)
+	}
+
+	// Prepare edit options - empty strings and nil flags are left unchanged by the server
+	opts := forgejo.EditReleaseOption{
+		IsDraft:      args.Draft,
+		IsPrerelease: args.Prerelease,
+	}
+	if args.NewTagName != nil {
+		opts.TagName = *args.NewTagName
+	}
+	if args.Target != nil {
+		opts.Target = *args.Target
+	}
+	if args.Name != nil {
+		opts.Title = *args.Name
+	}
+	if args.Body != nil {
+		opts.Note = *args.Body
+	}
+
+	release, _, err := c.client.EditRelease(owner, repoName, current.ID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit release: %w", err)
+	}
+
+	return convertRelease(release), nil
+}
+
+// UploadReleaseAsset uploads a file as an asset of a release
+func (c *ForgejoClient) UploadReleaseAsset(ctx context.Context, repo string, releaseID int, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if releaseID <= 0 {
+		return nil, fmt.Errorf("invalid release ID: %d, must be positive", releaseID)
+	}
+	if file.Filename == "" {
+		return nil, fmt.Errorf("asset filename is required")
+	}
+
+	attachment, _, err := c.client.CreateReleaseAttachment(owner, repoName, int64(releaseID), bytes.NewReader(file.Data), file.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload release asset %s: %w", file.Filename, err)
+	}
+
+	return convertAttachment(attachment), nil
+}
+
+// getReleaseByTag gets the release of a tag, reporting a missing release by its tag
+func (c *ForgejoClient) getReleaseByTag(owner, repo, tag string) (*forgejo.Release, error) {
+	if tag == "" {
+		return nil, fmt.Errorf("tag is required")
+	}
+
+	release, resp, err := c.client.GetReleaseByTag(owner, repo, tag)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("release not found for tag %s", tag)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %s: %w", tag, err)
+	}
+	if release == nil {
+		return nil, fmt.Errorf("release not found for tag %s", tag)
+	}
+	return release, nil
+}
+
+// convertRelease converts an SDK release to the interface type
+func convertRelease(release *forgejo.Release) *remote.Release {
+	result := &remote.Release{
+		ID:         int(release.ID),
+		TagName:    release.TagName,
+		Target:     release.Target,
+		Name:       release.Title,
+		Body:       release.Note,
+		Draft:      release.IsDraft,
+		Prerelease: release.IsPrerelease,
+		URL:        release.HTMLURL,
+	}
+	if release.Publisher != nil {
+		result.Author = release.Publisher.UserName
+	}
+	if !release.CreatedAt.IsZero() {
+		result.Created = release.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if !release.PublishedAt.IsZero() {
+		result.Published = release.PublishedAt.Format("2006-01-02T15:04:05Z")
+	}
+	for _, attachment := range release.Attachments {
+		if attachment != nil {
+			result.Assets = append(result.Assets, *convertAttachment(attachment))
+		}
+	}
+	return result
+}
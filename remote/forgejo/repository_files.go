@@ -0,0 +1,313 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// treePageSize is the number of tree entries requested per page, the server maximum
+const treePageSize = 1000
+
+// GetRepositoryFile fetches a file with its metadata from a repository at a ref, failing without
+// reading its content when it exceeds maxSize bytes
+func (c *ForgejoClient) GetRepositoryFile(ctx context.Context, repo, ref, filepath string, maxSize int64) (*remote.RepositoryFile, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	filepath = strings.Trim(filepath, "/")
+	if filepath == "" {
+		return nil, fmt.Errorf("file path is required")
+	}
+
+	contents, _, err := c.client.GetContents(owner, repoName, ref, filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file %s: %w", filepath, err)
+	}
+	if contents.Type != "file" {
+		return nil, fmt.Errorf("%s is a %s, not a file", filepath, contents.Type)
+	}
+	if maxSize > 0 && contents.Size > maxSize {
+		return nil, fmt.Errorf("file %s is %d bytes, exceeding the %d byte limit", filepath, contents.Size, maxSize)
+	}
+
+	var data []byte
+	switch {
+	case contents.Content == nil:
+		// The content is left out for large files, read them raw instead
+		if data, err = c.readRawFile(owner, repoName, ref, filepath, maxSize); err != nil {
+			return nil, fmt.Errorf("failed to get file %s: %w", filepath, err)
+		}
+	case contents.Encoding != nil && *contents.Encoding == "base64":
+		if data, err = base64.StdEncoding.DecodeString(*contents.Content); err != nil {
+			return nil, fmt.Errorf("failed to decode file %s: %w", filepath, err)
+		}
+	default:
+		data = []byte(*contents.Content)
+	}
+
+	return &remote.RepositoryFile{
+		Path:    contents.Path,
+		Name:    contents.Name,
+		SHA:     contents.SHA,
+		Size:    contents.Size,
+		Ref:     ref,
+		Content: data,
+	}, nil
+}
+
+// readRawFile reads the raw content of a file, failing once it exceeds maxSize bytes
+func (c *ForgejoClient) readRawFile(owner, repo, ref, filepath string, maxSize int64) ([]byte, error) {
+	body, _, err := c.client.GetFileReader(owner, repo, ref, filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	reader := io.Reader(body)
+	if maxSize > 0 {
+		reader = io.LimitReader(body, maxSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("file exceeds the %d byte limit", maxSize)
+	}
+	return data, nil
+}
+
+// ListRepositoryTree lists the entries below a directory of a repository at a ref, descending
+// into subdirectories when recursive is set. At most limit entries are returned.
+func (c *ForgejoClient) ListRepositoryTree(ctx context.Context, repo, ref, dirpath string, recursive bool, limit int) (*remote.RepositoryTree, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	// The trees API needs an explicit ref
+	if ref == "" {
+		repository, _, err := c.client.GetRepo(owner, repoName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default branch: %w", err)
+		}
+		ref = repository.DefaultBranch
+	}
+
+	// Subdirectories are listed by their tree SHA, found in the parent directory
+	treeSHA := ref
+	dirpath = strings.Trim(dirpath, "/")
+	if dirpath != "" {
+		parent := path.Dir(dirpath)
+		if parent == "." {
+			parent = ""
+		}
+		entries, _, err := c.client.ListContents(owner, repoName, ref, parent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list directory %s: %w", parent, err)
+		}
+		treeSHA = ""
+		for _, entry := range entries {
+			if entry.Path == dirpath && entry.Type == "dir" {
+				treeSHA = entry.SHA
+			}
+		}
+		if treeSHA == "" {
+			return nil, fmt.Errorf("directory %s not found at %s", dirpath, ref)
+		}
+	}
+
+	result := &remote.RepositoryTree{Ref: ref, Entries: []remote.TreeEntry{}}
+	for page := 1; ; page++ {
+		tree, _, err := c.client.GetTrees(owner, repoName, treeSHA, forgejo.GetTreesOptions{
+			Recursive:   recursive,
+			ListOptions: forgejo.ListOptions{Page: page, PageSize: treePageSize},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tree: %w", err)
+		}
+		result.SHA = tree.SHA
+		for _, entry := range tree.Entries {
+			if len(result.Entries) == limit {
+				result.Truncated = true
+				return result, nil
+			}
+			result.Entries = append(result.Entries, convertTreeEntry(dirpath, entry.Path, entry.Type, entry.Mode, entry.SHA, entry.Size))
+		}
+		if !tree.Truncated || len(tree.Entries) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// convertTreeEntry converts a tree entry relative to dirpath to an entry relative to the repository root
+func convertTreeEntry(dirpath, entryPath, entryType, mode, sha string, size int64) remote.TreeEntry {
+	if dirpath != "" {
+		entryPath = dirpath + "/" + entryPath
+	}
+	return remote.TreeEntry{
+		Path: entryPath,
+		Type: entryType,
+		Mode: mode,
+		Size: size,
+		SHA:  sha,
+	}
+}
+
+// WriteFile creates or updates a file on a branch in a single commit
+func (c *ForgejoClient) WriteFile(ctx context.Context, args remote.WriteFileArgs) (*remote.FileCommit, error) {
+	owner, repoName, filepath, err := c.fileChangeTarget(args.FileChangeArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkFileSHA(owner, repoName, args.Branch, filepath, args.SHA); err != nil {
+		return nil, err
+	}
+
+	content := base64.StdEncoding.EncodeToString(args.Content)
+	var response *forgejo.FileResponse
+	var resp *forgejo.Response
+	if args.SHA == "" {
+		response, resp, err = c.client.CreateFile(owner, repoName, filepath, forgejo.CreateFileOptions{
+			FileOptions: fileOptions(args.FileChangeArgs),
+			Content:     content,
+		})
+	} else {
+		response, resp, err = c.client.UpdateFile(owner, repoName, filepath, forgejo.UpdateFileOptions{
+			FileOptions: fileOptions(args.FileChangeArgs),
+			SHA:         args.SHA,
+			Content:     content,
+		})
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, fmt.Errorf("failed to write file %s: %w: %v", filepath, remote.ErrFileConflict, err)
+		}
+		return nil, fmt.Errorf("failed to write file %s: %w", filepath, err)
+	}
+
+	result := &remote.FileCommit{Path: filepath, Branch: fileChangeBranch(args.FileChangeArgs)}
+	if response.Content != nil {
+		result.SHA = response.Content.SHA
+	}
+	if response.Commit != nil {
+		result.CommitSHA = response.Commit.SHA
+		result.CommitURL = response.Commit.HTMLURL
+	}
+	return result, nil
+}
+
+// DeleteFile deletes a file from a branch in a single commit
+func (c *ForgejoClient) DeleteFile(ctx context.Context, args remote.FileChangeArgs) (*remote.FileCommit, error) {
+	owner, repoName, filepath, err := c.fileChangeTarget(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.SHA == "" {
+		return nil, fmt.Errorf("the SHA of %s is required to delete it", filepath)
+	}
+	if err := c.checkFileSHA(owner, repoName, args.Branch, filepath, args.SHA); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.DeleteFile(owner, repoName, filepath, forgejo.DeleteFileOptions{
+		FileOptions: fileOptions(args),
+		SHA:         args.SHA,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, fmt.Errorf("failed to delete file %s: %w: %v", filepath, remote.ErrFileConflict, err)
+		}
+		return nil, fmt.Errorf("failed to delete file %s: %w", filepath, err)
+	}
+
+	return &remote.FileCommit{Path: filepath, Branch: fileChangeBranch(args)}, nil
+}
+
+// fileChangeTarget checks the client and arguments of a file change and returns the owner,
+// repository name and cleaned file path
+func (c *ForgejoClient) fileChangeTarget(args remote.FileChangeArgs) (string, string, string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", "", "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return "", "", "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	filepath := strings.Trim(args.Path, "/")
+	if filepath == "" {
+		return "", "", "", fmt.Errorf("file path is required")
+	}
+	return owner, repoName, filepath, nil
+}
+
+// checkFileSHA rejects a change when the file on the branch does not have the SHA the caller
+// read: a file exists where none was expected, or it was changed or deleted since
+func (c *ForgejoClient) checkFileSHA(owner, repoName, branch, filepath, sha string) error {
+	contents, resp, err := c.client.GetContents(owner, repoName, branch, filepath)
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		if sha != "" {
+			return fmt.Errorf("%w: %s no longer exists", remote.ErrFileConflict, filepath)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to check file %s: %w", filepath, err)
+	case sha == "":
+		return fmt.Errorf("%w: %s already exists with SHA %s", remote.ErrFileConflict, filepath, contents.SHA)
+	case contents.SHA != sha:
+		return fmt.Errorf("%w: %s has SHA %s, not %s", remote.ErrFileConflict, filepath, contents.SHA, sha)
+	}
+	return nil
+}
+
+// fileOptions converts file change arguments to the commit options of the SDK
+func fileOptions(args remote.FileChangeArgs) forgejo.FileOptions {
+	return forgejo.FileOptions{
+		Message:       args.Message,
+		BranchName:    args.Branch,
+		NewBranchName: args.NewBranch,
+		Author:        forgejo.Identity{Name: args.Author.Name, Email: args.Author.Email},
+		Committer:     forgejo.Identity{Name: args.Committer.Name, Email: args.Committer.Email},
+	}
+}
+
+// fileChangeBranch returns the branch a file change is committed to
+func fileChangeBranch(args remote.FileChangeArgs) string {
+	if args.NewBranch != "" {
+		return args.NewBranch
+	}
+	return args.Branch
+}
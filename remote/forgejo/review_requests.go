@@ -0,0 +1,155 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// RequestReviewers requests reviews on a pull request from users and teams
+func (c *ForgejoClient) RequestReviewers(ctx context.Context, args remote.ReviewRequestArgs) error {
+	// Check if client is initialized
+	if c.client == nil {
+		return fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	if len(args.Reviewers) == 0 && len(args.TeamReviewers) == 0 {
+		return fmt.Errorf("no reviewers specified")
+	}
+
+	if err := c.validateUserAccess(ctx, owner, repoName, args.Reviewers, "read"); err != nil {
+		return fmt.Errorf("failed to request reviewers: %w", err)
+	}
+
+	_, err := c.client.CreateReviewRequests(owner, repoName, int64(args.PullRequestNumber), forgejo.PullReviewRequestOptions{
+		Reviewers:     args.Reviewers,
+		TeamReviewers: args.TeamReviewers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to request reviewers: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveReviewRequests removes pending review requests for users and teams from a pull request
+func (c *ForgejoClient) RemoveReviewRequests(ctx context.Context, args remote.ReviewRequestArgs) error {
+	// Check if client is initialized
+	if c.client == nil {
+		return fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	if len(args.Reviewers) == 0 && len(args.TeamReviewers) == 0 {
+		return fmt.Errorf("no reviewers specified")
+	}
+
+	_, err := c.client.DeleteReviewRequests(owner, repoName, int64(args.PullRequestNumber), forgejo.PullReviewRequestOptions{
+		Reviewers:     args.Reviewers,
+		TeamReviewers: args.TeamReviewers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove review requests: %w", err)
+	}
+
+	return nil
+}
+
+// accessLevels ranks the permissions reported by the collaborator permission endpoint
+var accessLevels = map[string]int{"none": 0, "read": 1, "write": 2, "admin": 3, "owner": 4}
+
+// validateUserAccess checks that every user exists and has at least the required permission
+// ("read" or "write") on the repository, so assignments and review requests fail with a clear
+// message instead of a generic API error. The permission endpoint accounts for organization team
+// access as well as collaborators. It is only available to repository admins; when the
+// authenticated user may not read a permission, that user is left to the server's own check.
+func (c *ForgejoClient) validateUserAccess(ctx context.Context, owner, repo string, users []string, required string) error {
+	var missing, outsiders []string
+	for _, user := range users {
+		_, resp, err := c.client.GetUserInfo(user)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				missing = append(missing, user)
+				continue
+			}
+			return fmt.Errorf("failed to get user %s: %w", user, err)
+		}
+
+		if strings.EqualFold(user, owner) {
+			continue
+		}
+
+		path := fmt.Sprintf("/repos/%s/%s/collaborators/%s/permission", url.PathEscape(owner), url.PathEscape(repo), url.PathEscape(user))
+		body, status, err := c.apiGet(ctx, path, nil)
+		if err != nil {
+			return fmt.Errorf("failed to check access of %s: %w", user, err)
+		}
+		if status == http.StatusForbidden {
+			continue
+		}
+		if status != http.StatusOK {
+			return fmt.Errorf("failed to check access of %s: %s", user, apiErrorMessage(status, body))
+		}
+		var permission struct {
+			Permission string `json:"permission"`
+		}
+		if err := json.Unmarshal(body, &permission); err != nil {
+			return fmt.Errorf("failed to check access of %s: invalid response: %w", user, err)
+		}
+		if accessLevels[permission.Permission] < accessLevels[required] {
+			outsiders = append(outsiders, user)
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "users not found: "+strings.Join(missing, ", "))
+	}
+	if len(outsiders) > 0 {
+		problems = append(problems, fmt.Sprintf("users without %s access to %s/%s: %s", required, owner, repo, strings.Join(outsiders, ", ")))
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// convertUserNames converts SDK users to their usernames
+func convertUserNames(users []*forgejo.User) []string {
+	if len(users) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		if u != nil {
+			names = append(names, u.UserName)
+		}
+	}
+	return names
+}
@@ -0,0 +1,103 @@
+package forgejo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// ListTags lists the git tags of a repository, newest first
+func (c *ForgejoClient) ListTags(ctx context.Context, repo string, limit, offset int) (*remote.TagList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := forgejo.ListRepoTagsOptions{
+		ListOptions: forgejo.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Forgejo uses 1-based pagination
+		},
+	}
+
+	forgejoTags, resp, err := c.client.ListRepoTags(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := make([]remote.Tag, 0, len(forgejoTags))
+	for _, tag := range forgejoTags {
+		if tag != nil {
+			tags = append(tags, convertTag(tag))
+		}
+	}
+
+	// Forgejo reports the number of tags in X-Total-Count; fall back to the page size
+	total := offset + len(tags)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.TagList{
+		Tags:   tags,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// CreateTag creates a tag at a branch or commit SHA, or at the default branch when target is
+// empty. A message makes it an annotated tag.
+func (c *ForgejoClient) CreateTag(ctx context.Context, repo, name, target, message string) (*remote.Tag, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+
+	tag, _, err := c.client.CreateTag(owner, repoName, forgejo.CreateTagOption{
+		TagName: name,
+		Target:  target,
+		Message: message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+
+	result := convertTag(tag)
+	return &result, nil
+}
+
+// convertTag converts an SDK tag to the interface type
+func convertTag(tag *forgejo.Tag) remote.Tag {
+	result := remote.Tag{Name: tag.Name, Message: tag.Message}
+	if tag.Commit != nil {
+		result.CommitSHA = tag.Commit.SHA
+	}
+	return result
+}
@@ -0,0 +1,198 @@
+package forgejo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// timelineComment is the timeline entry returned by the API. The SDK has no timeline support,
+// so the endpoint is queried directly.
+type timelineComment struct {
+	ID              int64              `json:"id"`
+	Type            string             `json:"type"`
+	Poster          *forgejo.User      `json:"user"`
+	Body            string             `json:"body"`
+	Created         time.Time          `json:"created_at"`
+	Label           *forgejo.Label     `json:"label"`
+	Milestone       *forgejo.Milestone `json:"milestone"`
+	OldMilestone    *forgejo.Milestone `json:"old_milestone"`
+	OldTitle        string             `json:"old_title"`
+	NewTitle        string             `json:"new_title"`
+	RefIssue        *forgejo.Issue     `json:"ref_issue"`
+	RefCommitSHA    string             `json:"ref_commit_sha"`
+	Assignee        *forgejo.User      `json:"assignee"`
+	AssigneeTeam    *forgejo.Team      `json:"assignee_team"`
+	RemovedAssignee bool               `json:"removed_assignee"`
+}
+
+// ListIssueTimeline retrieves the comments and system events of an issue or pull request in chronological order
+func (c *ForgejoClient) ListIssueTimeline(ctx context.Context, repo string, issueNumber int, limit, offset int) ([]remote.TimelineEvent, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if issueNumber <= 0 {
+		return nil, fmt.Errorf("invalid issue number: %d, must be positive", issueNumber)
+	}
+
+	pageSize := limit
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(offset/pageSize+1)) // Forgejo uses 1-based pagination
+	query.Set("limit", strconv.Itoa(pageSize))
+
+	path := fmt.Sprintf("/repos/%s/%s/issues/%d/timeline", url.PathEscape(owner), url.PathEscape(repoName), issueNumber)
+	body, status, err := c.apiGet(ctx, path, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue timeline: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to list issue timeline: %s", apiErrorMessage(status, body))
+	}
+
+	var comments []*timelineComment
+	if err := json.Unmarshal(body, &comments); err != nil {
+		return nil, fmt.Errorf("failed to list issue timeline: %w", err)
+	}
+
+	events := make([]remote.TimelineEvent, 0, len(comments))
+	for _, tc := range comments {
+		if tc != nil {
+			events = append(events, convertTimelineEvent(tc))
+		}
+	}
+
+	// The API already merges comments and events; keep the order stable by creation time
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Created < events[j].Created
+	})
+
+	return events, nil
+}
+
+// convertTimelineEvent converts a timeline entry to our TimelineEvent, classifying it and
+// describing it in a short summary
+func convertTimelineEvent(tc *timelineComment) remote.TimelineEvent {
+	event := remote.TimelineEvent{
+		ID:       int(tc.ID),
+		Type:     tc.Type,
+		Category: remote.TimelineCategoryOther,
+		Actor:    "unknown",
+		Created:  tc.Created.UTC().Format("2006-01-02T15:04:05Z"),
+		Summary:  strings.ReplaceAll(tc.Type, "_", " "),
+	}
+	if tc.Poster != nil {
+		event.Actor = tc.Poster.UserName
+	}
+
+	added := "added"
+	if tc.RemovedAssignee {
+		added = "removed"
+	}
+
+	switch tc.Type {
+	case "comment":
+		event.Category = remote.TimelineCategoryComment
+		event.Body = tc.Body
+		event.Summary = "commented"
+	case "close", "reopen", "merge_pull":
+		event.Category = remote.TimelineCategoryState
+		event.Action = map[string]string{"close": "closed", "reopen": "reopened", "merge_pull": "merged"}[tc.Type]
+		event.Summary = event.Action + " this"
+	case "label":
+		event.Category = remote.TimelineCategoryLabel
+		// The body is "1" when the label was added and empty when it was removed
+		event.Action = "removed"
+		if tc.Body == "1" {
+			event.Action = "added"
+		}
+		if tc.Label != nil {
+			event.Label = tc.Label.Name
+		}
+		event.Summary = fmt.Sprintf("%s label %s", event.Action, event.Label)
+	case "milestone":
+		event.Category = remote.TimelineCategoryMilestone
+		if tc.Milestone != nil {
+			event.Milestone = tc.Milestone.Title
+		}
+		if tc.OldMilestone != nil {
+			event.OldMilestone = tc.OldMilestone.Title
+		}
+		switch {
+		case event.Milestone == "":
+			event.Action = "removed"
+			event.Summary = "removed milestone " + event.OldMilestone
+		case event.OldMilestone == "":
+			event.Action = "added"
+			event.Summary = "added milestone " + event.Milestone
+		default:
+			event.Action = "changed"
+			event.Summary = fmt.Sprintf("changed milestone from %s to %s", event.OldMilestone, event.Milestone)
+		}
+	case "assignees":
+		event.Category = remote.TimelineCategoryAssignee
+		event.Action = added
+		if tc.Assignee != nil {
+			event.Assignee = tc.Assignee.UserName
+		}
+		event.Summary = fmt.Sprintf("%s assignee %s", added, event.Assignee)
+	case "review_request":
+		event.Category = remote.TimelineCategoryReviewRequest
+		event.Action = added
+		if tc.Assignee != nil {
+			event.Reviewer = tc.Assignee.UserName
+		} else if tc.AssigneeTeam != nil {
+			event.Reviewer = "team " + tc.AssigneeTeam.Name
+		}
+		if tc.RemovedAssignee {
+			event.Summary = "removed review request for " + event.Reviewer
+		} else {
+			event.Summary = "requested review from " + event.Reviewer
+		}
+	case "review":
+		event.Category = remote.TimelineCategoryReview
+		event.Body = tc.Body
+		event.Summary = "reviewed"
+	case "issue_ref", "comment_ref", "pull_ref":
+		event.Category = remote.TimelineCategoryReference
+		if tc.RefIssue != nil {
+			event.RefIssue = fmt.Sprintf("#%d", tc.RefIssue.Index)
+			if tc.RefIssue.Repository != nil {
+				event.RefIssue = tc.RefIssue.Repository.FullName + event.RefIssue
+			}
+		}
+		event.Summary = "referenced this in " + event.RefIssue
+	case "commit_ref":
+		event.Category = remote.TimelineCategoryReference
+		event.RefCommit = tc.RefCommitSHA
+		event.Summary = "referenced this in commit " + event.RefCommit
+	case "change_title":
+		event.Category = remote.TimelineCategoryTitle
+		event.Action = "changed"
+		event.OldTitle = tc.OldTitle
+		event.NewTitle = tc.NewTitle
+		event.Summary = fmt.Sprintf("changed title from %q to %q", tc.OldTitle, tc.NewTitle)
+	}
+
+	return event
+}
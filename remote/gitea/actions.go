@@ -0,0 +1,264 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// giteaActionRun mirrors the Gitea API representation of a workflow run (Gitea 1.24+)
+type giteaActionRun struct {
+	ID           int64     `json:"id"`
+	DisplayTitle string    `json:"display_title"`
+	Path         string    `json:"path"` // Workflow file and ref, e.g. "ci.yml@refs/heads/main"
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	Event        string    `json:"event"`
+	RunNumber    int64     `json:"run_number"`
+	HeadBranch   string    `json:"head_branch"`
+	HeadSHA      string    `json:"head_sha"`
+	HTMLURL      string    `json:"html_url"`
+	StartedAt    time.Time `json:"started_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+	TriggerActor *struct {
+		UserName string `json:"login"`
+	} `json:"trigger_actor"`
+}
+
+// giteaActionJob mirrors the Gitea API representation of a workflow job
+type giteaActionJob struct {
+	ID          int64     `json:"id"`
+	RunID       int64     `json:"run_id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	HTMLURL     string    `json:"html_url"`
+}
+
+// ListActionRuns lists Gitea Actions workflow runs of a repository, newest first.
+// Servers without the runs API (before Gitea 1.24) report ErrUnsupported.
+func (c *GiteaClient) ListActionRuns(ctx context.Context, args remote.ListActionRunsArgs) (*remote.ActionRunList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.Limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", args.Limit)
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(args.Limit))
+	query.Set("page", strconv.Itoa(args.Offset/args.Limit+1)) // Gitea uses 1-based pagination
+	if args.Branch != "" {
+		query.Set("branch", args.Branch)
+	}
+	if args.HeadSHA != "" {
+		query.Set("head_sha", args.HeadSHA)
+	}
+	if args.Status != "" {
+		query.Set("status", args.Status)
+	}
+	if args.Event != "" {
+		query.Set("event", args.Event)
+	}
+
+	var response struct {
+		WorkflowRuns []giteaActionRun `json:"workflow_runs"`
+		TotalCount   int              `json:"total_count"`
+	}
+	body, status, err := c.apiGet(ctx, actionsPath(owner, repoName, "/runs"), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list action runs: %w", err)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// Servers before the runs API was introduced do not route the endpoint at all
+		return nil, fmt.Errorf("failed to list action runs: %w: actions runs API not available for %s", remote.ErrUnsupported, args.Repository)
+	default:
+		return nil, fmt.Errorf("failed to list action runs: %s", apiErrorMessage(status, body))
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to list action runs: invalid response: %w", err)
+	}
+
+	runs := make([]remote.ActionRun, len(response.WorkflowRuns))
+	for i, run := range response.WorkflowRuns {
+		triggerUser := ""
+		if run.TriggerActor != nil {
+			triggerUser = run.TriggerActor.UserName
+		}
+		workflow, _, _ := strings.Cut(run.Path, "@")
+		runs[i] = remote.ActionRun{
+			ID:          int(run.ID),
+			RunNumber:   int(run.RunNumber),
+			Title:       run.DisplayTitle,
+			WorkflowID:  path.Base(workflow),
+			Status:      actionRunStatus(run.Status, run.Conclusion),
+			Event:       run.Event,
+			Branch:      run.HeadBranch,
+			CommitSHA:   run.HeadSHA,
+			TriggerUser: triggerUser,
+			Created:     formatActionTime(run.StartedAt),
+			Started:     formatActionTime(run.StartedAt),
+			Stopped:     formatActionTime(run.CompletedAt),
+			HTMLURL:     run.HTMLURL,
+		}
+	}
+
+	total := response.TotalCount
+	return &remote.ActionRunList{
+		Runs:   runs,
+		Total:  &total,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	}, nil
+}
+
+// ListActionJobs lists the jobs of a Gitea Actions workflow run
+func (c *GiteaClient) ListActionJobs(ctx context.Context, repo string, runID int) ([]remote.ActionJob, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if runID <= 0 {
+		return nil, fmt.Errorf("invalid run ID: %d, must be positive", runID)
+	}
+
+	var response struct {
+		Jobs []giteaActionJob `json:"jobs"`
+	}
+	body, status, err := c.apiGet(ctx, actionsPath(owner, repoName, fmt.Sprintf("/runs/%d/jobs", runID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list action jobs: %w", err)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		if err := c.checkActionsSupported(ctx, owner, repoName); err != nil {
+			return nil, fmt.Errorf("failed to list action jobs: %w", err)
+		}
+		return nil, fmt.Errorf("failed to list action jobs: run %d not found in %s", runID, repo)
+	default:
+		return nil, fmt.Errorf("failed to list action jobs: %s", apiErrorMessage(status, body))
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to list action jobs: invalid response: %w", err)
+	}
+
+	jobs := make([]remote.ActionJob, len(response.Jobs))
+	for i, job := range response.Jobs {
+		jobs[i] = remote.ActionJob{
+			ID:         int(job.ID),
+			RunID:      int(job.RunID),
+			Name:       job.Name,
+			Status:     job.Status,
+			Conclusion: job.Conclusion,
+			Started:    formatActionTime(job.StartedAt),
+			Completed:  formatActionTime(job.CompletedAt),
+			HTMLURL:    job.HTMLURL,
+		}
+	}
+
+	return jobs, nil
+}
+
+// GetActionJobLog fetches the full plain-text log of a Gitea Actions job
+func (c *GiteaClient) GetActionJobLog(ctx context.Context, repo string, jobID int) (string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if jobID <= 0 {
+		return "", fmt.Errorf("invalid job ID: %d, must be positive", jobID)
+	}
+
+	body, status, err := c.apiGet(ctx, actionsPath(owner, repoName, fmt.Sprintf("/jobs/%d/logs", jobID)), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get action job log: %w", err)
+	}
+	switch status {
+	case http.StatusOK:
+		return string(body), nil
+	case http.StatusNotFound:
+		if err := c.checkActionsSupported(ctx, owner, repoName); err != nil {
+			return "", fmt.Errorf("failed to get action job log: %w", err)
+		}
+		return "", fmt.Errorf("failed to get action job log: job %d not found in %s", jobID, repo)
+	default:
+		return "", fmt.Errorf("failed to get action job log: %s", apiErrorMessage(status, body))
+	}
+}
+
+// checkActionsSupported probes the runs endpoint to tell a missing run or job apart from a
+// server without the Actions API, which is reported as ErrUnsupported
+func (c *GiteaClient) checkActionsSupported(ctx context.Context, owner, repoName string) error {
+	_, status, err := c.apiGet(ctx, actionsPath(owner, repoName, "/runs"), url.Values{"limit": {"1"}})
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		return fmt.Errorf("%w: actions API not available for %s/%s", remote.ErrUnsupported, owner, repoName)
+	}
+	return nil
+}
+
+// actionsPath builds the path of a repository Actions endpoint
+func actionsPath(owner, repoName, endpoint string) string {
+	return fmt.Sprintf("/repos/%s/%s/actions%s", url.PathEscape(owner), url.PathEscape(repoName), endpoint)
+}
+
+// actionRunStatus maps the GitHub-style status and conclusion of a Gitea run onto the
+// Forgejo run states, e.g. "completed" with conclusion "failure" becomes "failure"
+func actionRunStatus(status, conclusion string) string {
+	switch status {
+	case "completed":
+		if conclusion != "" {
+			return conclusion
+		}
+	case "in_progress":
+		return "running"
+	case "queued", "pending":
+		return "waiting"
+	}
+	return status
+}
+
+// formatActionTime formats an Actions timestamp, leaving unset times empty
+func formatActionTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z")
+}
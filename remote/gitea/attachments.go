@@ -0,0 +1,220 @@
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// UploadIssueAttachment uploads a file to an issue or pull request
+func (c *GiteaClient) UploadIssueAttachment(ctx context.Context, repo string, number int, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if number <= 0 {
+		return nil, fmt.Errorf("invalid issue number: %d, must be positive", number)
+	}
+
+	return c.uploadAttachment(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/assets", owner, repoName, number), file)
+}
+
+// UploadCommentAttachment uploads a file to an issue or pull request comment
+func (c *GiteaClient) UploadCommentAttachment(ctx context.Context, repo string, commentID int, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if commentID <= 0 {
+		return nil, fmt.Errorf("invalid comment ID: %d, must be positive", commentID)
+	}
+
+	return c.uploadAttachment(ctx, fmt.Sprintf("/repos/%s/%s/issues/comments/%d/assets", owner, repoName, commentID), file)
+}
+
+// uploadAttachment posts a file to an asset endpoint as multipart form data. The SDK only
+// covers release assets, so the request goes through the raw API.
+func (c *GiteaClient) uploadAttachment(ctx context.Context, path string, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+	if file.Filename == "" {
+		return nil, fmt.Errorf("attachment filename is required")
+	}
+
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	part, err := writer.CreateFormFile("attachment", file.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	if _, err := part.Write(file.Data); err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+
+	body, _, status, err := c.apiRequest(ctx, http.MethodPost, path, url.Values{"name": {file.Filename}}, writer.FormDataContentType(), &form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	if status != http.StatusCreated && status != http.StatusOK {
+		return nil, fmt.Errorf("failed to upload attachment %s: %s", file.Filename, apiErrorMessage(status, body))
+	}
+
+	var attachment gitea.Attachment
+	if err := json.Unmarshal(body, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to decode attachment: %w", err)
+	}
+	return convertAttachment(&attachment), nil
+}
+
+// convertAttachment converts an SDK attachment to the interface type
+func convertAttachment(attachment *gitea.Attachment) *remote.Attachment {
+	result := &remote.Attachment{
+		ID:          int(attachment.ID),
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		UUID:        attachment.UUID,
+		DownloadURL: attachment.DownloadURL,
+	}
+	if !attachment.Created.IsZero() {
+		result.Created = attachment.Created.Format("2006-01-02T15:04:05Z")
+	}
+	return result
+}
+
+// ListAttachments lists the files attached to an issue, pull request or comment
+func (c *GiteaClient) ListAttachments(ctx context.Context, target remote.IssueOrCommentTarget) ([]remote.Attachment, error) {
+	path, err := c.attachmentPath(target)
+	if err != nil {
+		return nil, err
+	}
+
+	body, status, err := c.apiGet(ctx, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to list attachments: %s", apiErrorMessage(status, body))
+	}
+
+	var sdkAttachments []*gitea.Attachment
+	if err := json.Unmarshal(body, &sdkAttachments); err != nil {
+		return nil, fmt.Errorf("failed to decode attachments: %w", err)
+	}
+	attachments := make([]remote.Attachment, 0, len(sdkAttachments))
+	for _, attachment := range sdkAttachments {
+		attachments = append(attachments, *convertAttachment(attachment))
+	}
+	return attachments, nil
+}
+
+// GetAttachment gets a single file attached to an issue, pull request or comment
+func (c *GiteaClient) GetAttachment(ctx context.Context, target remote.IssueOrCommentTarget, id int) (*remote.Attachment, error) {
+	path, err := c.attachmentPath(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid attachment ID: %d, must be positive", id)
+	}
+
+	body, status, err := c.apiGet(ctx, fmt.Sprintf("%s/%d", path, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("attachment %d not found", id)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to get attachment: %s", apiErrorMessage(status, body))
+	}
+
+	var attachment gitea.Attachment
+	if err := json.Unmarshal(body, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to decode attachment: %w", err)
+	}
+	return convertAttachment(&attachment), nil
+}
+
+// DownloadAttachment downloads the content of an attached file, failing once it exceeds maxSize
+// bytes. The access token is only sent when the file is served by the configured remote.
+func (c *GiteaClient) DownloadAttachment(ctx context.Context, attachment *remote.Attachment, maxSize int64) ([]byte, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	if attachment == nil || attachment.DownloadURL == "" {
+		return nil, fmt.Errorf("attachment has no download URL")
+	}
+	if maxSize > 0 && attachment.Size > maxSize {
+		return nil, fmt.Errorf("attachment %s is %d bytes, exceeding the %d byte limit", attachment.Name, attachment.Size, maxSize)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.DownloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
+	}
+	if remoteURL, err := url.Parse(c.baseURL); err == nil && c.token != "" && req.URL.Host == remoteURL.Host {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download attachment %s: unexpected status %d", attachment.Name, resp.StatusCode)
+	}
+
+	reader := io.Reader(resp.Body)
+	if maxSize > 0 {
+		reader = io.LimitReader(resp.Body, maxSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("attachment %s exceeds the %d byte limit", attachment.Name, maxSize)
+	}
+	return data, nil
+}
+
+// attachmentPath checks the client and target and returns the API path of the target's assets
+func (c *GiteaClient) attachmentPath(target remote.IssueOrCommentTarget) (string, error) {
+	owner, repoName, err := c.issueOrCommentTarget(target)
+	if err != nil {
+		return "", err
+	}
+	if target.CommentID > 0 {
+		return fmt.Sprintf("/repos/%s/%s/issues/comments/%d/assets", owner, repoName, target.CommentID), nil
+	}
+	return fmt.Sprintf("/repos/%s/%s/issues/%d/assets", owner, repoName, target.IssueNumber), nil
+}
@@ -109,3 +109,483 @@ func TestGiteaClient_EditPullRequest_NilClient(t *testing.T) {
 		t.Errorf("expected error %q, got %q", expectedErr, err.Error())
 	}
 }
+
+func TestGiteaClient_MergePullRequest_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that MergePullRequest handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	args := remote.MergePullRequestArgs{
+		Repository:        "testuser/testrepo",
+		PullRequestNumber: 123,
+		Style:             remote.MergeStyleSquash,
+	}
+
+	// This should return an error due to nil client, not panic
+	_, err := client.MergePullRequest(ctx, args)
+
+	if err == nil {
+		t.Error("expected error due to nil client, but no error occurred")
+	}
+
+	expectedErr := "client not initialized"
+	if err.Error() != expectedErr {
+		t.Errorf("expected error %q, got %q", expectedErr, err.Error())
+	}
+}
+
+func TestGiteaClient_PullRequestReviews_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that review methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+
+	_, err := client.CreatePullRequestReview(ctx, remote.CreatePullRequestReviewArgs{
+		Repository:        "testuser/testrepo",
+		PullRequestNumber: 1,
+		State:             remote.ReviewStateApproved,
+	})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("CreatePullRequestReview: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.ListPullRequestReviews(ctx, "testuser/testrepo", 1, 15, 0)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListPullRequestReviews: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.ListPullRequestReviewComments(ctx, "testuser/testrepo", 1, 1)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListPullRequestReviewComments: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_PullRequestDiff_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that diff methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+
+	_, err := client.GetPullRequestDiff(ctx, "testuser/testrepo", 1)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("GetPullRequestDiff: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.ListPullRequestFiles(ctx, "testuser/testrepo", 1, 15, 0)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListPullRequestFiles: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_GetCombinedCommitStatus_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that GetCombinedCommitStatus handles nil client gracefully
+	client := &GiteaClient{}
+	_, err := client.GetCombinedCommitStatus(context.Background(), "testuser/testrepo", "main")
+	if err == nil || err.Error() != "client not initialized" {
+		t.Errorf("Expected 'client not initialized' error, got %v", err)
+	}
+}
+
+func TestGiteaClient_Actions_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that Actions methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+
+	_, err := client.ListActionRuns(ctx, remote.ListActionRunsArgs{Repository: "testuser/testrepo", Limit: 15})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListActionRuns: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.ListActionJobs(ctx, "testuser/testrepo", 1)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListActionJobs: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.GetActionJobLog(ctx, "testuser/testrepo", 1)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("GetActionJobLog: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_Labels_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that label methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+
+	_, err := client.ListLabels(ctx, "testuser/testrepo", 15, 0)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListLabels: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.CreateLabel(ctx, remote.CreateLabelArgs{Repository: "testuser/testrepo", Name: "bug", Color: "#ee0701"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("CreateLabel: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.EditLabel(ctx, remote.EditLabelArgs{Repository: "testuser/testrepo", Name: "bug"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("EditLabel: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.AddIssueLabels(ctx, "testuser/testrepo", 1, []string{"bug"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("AddIssueLabels: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.RemoveIssueLabels(ctx, "testuser/testrepo", 1, []string{"bug"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("RemoveIssueLabels: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_Milestones_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that milestone methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+
+	_, err := client.ListMilestones(ctx, "testuser/testrepo", "open", 15, 0)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListMilestones: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.CreateMilestone(ctx, remote.CreateMilestoneArgs{Repository: "testuser/testrepo", Title: "v1.0"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("CreateMilestone: expected error %q, got %v", expectedErr, err)
+	}
+
+	_, err = client.EditMilestone(ctx, remote.EditMilestoneArgs{Repository: "testuser/testrepo", Title: "v1.0"})
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("EditMilestone: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ReviewRequests_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that review request methods handle nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+	expectedErr := "client not initialized"
+	args := remote.ReviewRequestArgs{Repository: "testuser/testrepo", PullRequestNumber: 1, Reviewers: []string{"alice"}}
+
+	err := client.RequestReviewers(ctx, args)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("RequestReviewers: expected error %q, got %v", expectedErr, err)
+	}
+
+	err = client.RemoveReviewRequests(ctx, args)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("RemoveReviewRequests: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_SearchIssues_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that SearchIssues handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.SearchIssues(ctx, remote.SearchIssuesOptions{Limit: 10})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("SearchIssues: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_GetIssue_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that GetIssue handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.GetIssue(ctx, "testuser/testrepo", 1)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("GetIssue: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListIssueTimeline_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListIssueTimeline handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListIssueTimeline(ctx, "testuser/testrepo", 1, 10, 0)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListIssueTimeline: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_DeleteComment_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that DeleteComment handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	err := client.DeleteComment(ctx, remote.DeleteCommentArgs{Repository: "testuser/testrepo", IssueNumber: 1, CommentID: 1})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("DeleteComment: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListReactions_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListReactions handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListReactions(ctx, remote.IssueOrCommentTarget{Repository: "testuser/testrepo", IssueNumber: 1})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListReactions: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_MarkNotification_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that MarkNotification handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.MarkNotification(ctx, 1, remote.NotificationStatusRead)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("MarkNotification: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListUnreadNotificationDetails_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListUnreadNotificationDetails handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListUnreadNotificationDetails(ctx, "owner/repo")
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListUnreadNotificationDetails: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_EnrichNotificationDetails_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that EnrichNotificationDetails handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	err := client.EnrichNotificationDetails(ctx, []remote.NotificationDetails{})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("EnrichNotificationDetails: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_UploadIssueAttachment_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that UploadIssueAttachment handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.UploadIssueAttachment(ctx, "owner/repo", 1, remote.ProcessedAttachment{Data: []byte("data"), Filename: "file.txt"})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("UploadIssueAttachment: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListAttachments_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListAttachments handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListAttachments(ctx, remote.IssueOrCommentTarget{Repository: "owner/repo", IssueNumber: 1})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListAttachments: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_GetRepositoryFile_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that GetRepositoryFile handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.GetRepositoryFile(ctx, "owner/repo", "main", "README.md", 0)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("GetRepositoryFile: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListRepositoryTree_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListRepositoryTree handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListRepositoryTree(ctx, "owner/repo", "main", "", false, 100)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListRepositoryTree: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_WriteFile_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that WriteFile handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.WriteFile(ctx, remote.WriteFileArgs{FileChangeArgs: remote.FileChangeArgs{Repository: "owner/repo", Path: "README.md", Message: "Update"}})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("WriteFile: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_DeleteFile_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that DeleteFile handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.DeleteFile(ctx, remote.FileChangeArgs{Repository: "owner/repo", Path: "README.md", Message: "Remove", SHA: "abc"})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("DeleteFile: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListBranches_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListBranches handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListBranches(ctx, "owner/repo", 10, 0)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListBranches: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_CompareBranches_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that CompareBranches handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.CompareBranches(ctx, "owner/repo", "main", "feature", 10)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("CompareBranches: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListCommits_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListCommits handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListCommits(ctx, "owner/repo", remote.ListCommitsOptions{Limit: 10})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListCommits: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_GetCommit_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that GetCommit handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.GetCommit(ctx, "owner/repo", "abc123")
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("GetCommit: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_ListReleases_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that ListReleases handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.ListReleases(ctx, "owner/repo", 10, 0)
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("ListReleases: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_UploadReleaseAsset_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that UploadReleaseAsset handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.UploadReleaseAsset(ctx, "owner/repo", 1, remote.ProcessedAttachment{Data: []byte("data"), Filename: "app.zip"})
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("UploadReleaseAsset: expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestGiteaClient_CreateTag_NilClient(t *testing.T) {
+	t.Parallel()
+
+	// Test that CreateTag handles nil client gracefully
+	client := &GiteaClient{}
+	ctx := context.Background()
+
+	_, err := client.CreateTag(ctx, "owner/repo", "v1.0.0", "", "")
+	expectedErr := "client not initialized"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("CreateTag: expected error %q, got %v", expectedErr, err)
+	}
+}
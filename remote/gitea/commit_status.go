@@ -0,0 +1,68 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// GetCombinedCommitStatus fetches the combined CI status and individual check contexts for a ref
+func (c *GiteaClient) GetCombinedCommitStatus(ctx context.Context, repo, ref string) (*remote.CombinedCommitStatus, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if strings.TrimSpace(ref) == "" {
+		return nil, fmt.Errorf("ref is required")
+	}
+
+	combined, _, err := c.client.GetCombinedStatus(owner, repoName, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit status: %w", err)
+	}
+
+	statuses := make([]remote.CommitStatus, len(combined.Statuses))
+	for i, status := range combined.Statuses {
+		creator := ""
+		if status.Creator != nil {
+			creator = status.Creator.UserName
+		}
+		statuses[i] = remote.CommitStatus{
+			ID:          int(status.ID),
+			Context:     status.Context,
+			State:       string(status.State),
+			Description: status.Description,
+			TargetURL:   status.TargetURL,
+			Creator:     creator,
+			Created:     status.Created.Format("2006-01-02T15:04:05Z"),
+			Updated:     status.Updated.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	sha := combined.SHA
+	if sha == "" {
+		sha = ref
+	}
+
+	// The server pages the statuses; total_count reports all of them
+	total := combined.TotalCount
+	if total < len(statuses) {
+		total = len(statuses)
+	}
+
+	return &remote.CombinedCommitStatus{
+		State:      string(combined.State),
+		SHA:        sha,
+		TotalCount: total,
+		Statuses:   statuses,
+	}, nil
+}
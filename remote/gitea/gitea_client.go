@@ -2,9 +2,14 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/kunde21/forgejo-mcp/remote"
@@ -13,6 +18,11 @@ import (
 // GiteaClient implements IssueLister using the Gitea SDK
 type GiteaClient struct {
 	client *gitea.Client
+
+	// Used for API endpoints the SDK does not cover yet, such as the full issue timeline
+	baseURL    string
+	token      string
+	httpClient *http.Client
 }
 
 // NewGiteaClient creates a new Gitea client
@@ -35,24 +45,58 @@ func NewGiteaClientWithHTTPClient(url, token string, httpClient *http.Client) (*
 		return nil, fmt.Errorf("failed to create Gitea client: %w", err)
 	}
 
-	return &GiteaClient{client: client}, nil
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &GiteaClient{
+		client:     client,
+		baseURL:    strings.TrimSuffix(url, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}, nil
 }
 
-// ListIssues retrieves issues from the specified repository
-func (c *GiteaClient) ListIssues(ctx context.Context, repo string, limit, offset int) ([]remote.Issue, error) {
+// ListIssues retrieves issues from the specified repository matching the given filters
+func (c *GiteaClient) ListIssues(ctx context.Context, repo string, options remote.ListIssuesOptions) ([]remote.Issue, error) {
 	// Parse repository string (format: "owner/repo")
 	owner, repoName, ok := strings.Cut(repo, "/")
 	if !ok {
 		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
 	}
 
+	if options.Sort != "" && options.Sort != remote.IssueSortLatest {
+		return nil, fmt.Errorf("failed to list issues: %w: sorting issues requires a Forgejo remote", remote.ErrUnsupported)
+	}
+
+	pageSize := options.Limit
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+
+	state := gitea.StateType(options.State)
+	if state == "" {
+		state = gitea.StateOpen
+	}
+
 	// List issues using Gitea SDK
 	opts := gitea.ListIssueOption{
 		ListOptions: gitea.ListOptions{
-			PageSize: limit,
-			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+			PageSize: pageSize,
+			Page:     options.Offset/pageSize + 1, // Gitea uses 1-based pagination
 		},
-		State: gitea.StateOpen, // Only open issues for now
+		State:       state,
+		Type:        gitea.IssueType(options.Type),
+		Labels:      options.Labels,
+		KeyWord:     options.Query,
+		Since:       options.Since,
+		Before:      options.Before,
+		CreatedBy:   options.Author,
+		AssignedBy:  options.Assignee,
+		MentionedBy: options.Mentioned,
+	}
+	if options.Milestone != "" {
+		opts.Milestones = []string{options.Milestone}
 	}
 
 	giteaIssues, _, err := c.client.ListRepoIssues(owner, repoName, opts)
@@ -63,23 +107,112 @@ func (c *GiteaClient) ListIssues(ctx context.Context, repo string, limit, offset
 	// Convert to our Issue struct
 	issues := make([]remote.Issue, len(giteaIssues))
 	for i, gi := range giteaIssues {
-		author := "unknown"
-		if gi.Poster != nil {
-			author = gi.Poster.UserName
-		}
+		issues[i] = convertIssue(gi)
+	}
+
+	return issues, nil
+}
+
+// SearchIssues searches issues and pull requests across all repositories visible to the
+// authenticated user
+func (c *GiteaClient) SearchIssues(ctx context.Context, options remote.SearchIssuesOptions) ([]remote.Issue, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	pageSize := options.Limit
+	if pageSize <= 0 {
+		pageSize = 10 // Default page size
+	}
+
+	state := options.State
+	if state == "" {
+		state = string(gitea.StateOpen)
+	}
+
+	// The SDK sends involvement as created_by/assigned_by/mentioned_by, which the search
+	// endpoint ignores, and encodes the team filter from the wrong field, so the endpoint
+	// is queried directly
+	query := url.Values{}
+	query.Set("state", state)
+	query.Set("page", strconv.Itoa(options.Offset/pageSize+1)) // Gitea uses 1-based pagination
+	query.Set("limit", strconv.Itoa(pageSize))
+	if options.Type != "" {
+		query.Set("type", options.Type)
+	}
+	if options.Owner != "" {
+		query.Set("owner", options.Owner)
+	}
+	if options.Team != "" {
+		query.Set("team", options.Team)
+	}
+	if options.Involvement != "" {
+		query.Set(options.Involvement, "true")
+	}
+	if len(options.Labels) > 0 {
+		query.Set("labels", strings.Join(options.Labels, ","))
+	}
+	if options.Milestone != "" {
+		query.Set("milestones", options.Milestone)
+	}
+	if options.Query != "" {
+		query.Set("q", options.Query)
+	}
+	if !options.Since.IsZero() {
+		query.Set("since", options.Since.Format(time.RFC3339))
+	}
+	if !options.Before.IsZero() {
+		query.Set("before", options.Before.Format(time.RFC3339))
+	}
 
-		issues[i] = remote.Issue{
-			ID:     int(gi.ID),
-			Number: int(gi.Index),
-			Title:  gi.Title,
-			State:  string(gi.State),
-			User:   author,
+	body, status, err := c.apiGet(ctx, "/repos/issues/search", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to search issues: %s", apiErrorMessage(status, body))
+	}
+
+	var giteaIssues []*gitea.Issue
+	if err := json.Unmarshal(body, &giteaIssues); err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues := make([]remote.Issue, len(giteaIssues))
+	for i, gi := range giteaIssues {
+		issues[i] = convertIssue(gi)
+		if gi.Repository != nil {
+			issues[i].Repository = gi.Repository.FullName
 		}
 	}
 
 	return issues, nil
 }
 
+// convertIssue converts an SDK issue from a list or search response to our Issue struct
+func convertIssue(gi *gitea.Issue) remote.Issue {
+	author := "unknown"
+	if gi.Poster != nil {
+		author = gi.Poster.UserName
+	}
+
+	return remote.Issue{
+		ID:          int(gi.ID),
+		Number:      int(gi.Index),
+		Title:       gi.Title,
+		State:       string(gi.State),
+		Body:        gi.Body,
+		User:        author,
+		Created:     gi.Created.Format("2006-01-02T15:04:05Z"),
+		Updated:     gi.Updated.Format("2006-01-02T15:04:05Z"),
+		Labels:      convertLabels(gi.Labels),
+		Milestone:   convertMilestone(gi.Milestone),
+		Assignees:   convertUserNames(gi.Assignees),
+		PullRequest: gi.PullRequest != nil,
+	}
+}
+
 // CreateIssueComment creates a comment on the specified issue
 func (c *GiteaClient) CreateIssueComment(ctx context.Context, repo string, issueNumber int, comment string) (*remote.Comment, error) {
 	// Parse repository string (format: "owner/repo")
@@ -143,6 +276,8 @@ func (c *GiteaClient) ListIssueComments(ctx context.Context, repo string, issueN
 		}
 	}
 
+	c.attachCommentReactions(ctx, owner, repoName, comments)
+
 	// Create IssueCommentList with pagination metadata
 	// Note: Gitea SDK doesn't provide total count in ListIssueComments response
 	// We return the actual number of comments returned as total
@@ -280,6 +415,8 @@ func (c *GiteaClient) ListPullRequestComments(ctx context.Context, repo string,
 		}
 	}
 
+	c.attachCommentReactions(ctx, owner, repoName, comments)
+
 	// Create PullRequestCommentList with pagination metadata
 	// Note: Gitea SDK doesn't provide total count in ListPullRequestComments response
 	// We return the actual number of comments returned as total
@@ -404,14 +541,39 @@ func (c *GiteaClient) EditPullRequest(ctx context.Context, args remote.EditPullR
 		hasChanges = true
 	}
 
-	if !hasChanges {
+	if args.Assignees != nil {
+		if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+		editOptions.Assignees = args.Assignees
+		hasChanges = true
+	}
+
+	if !hasChanges && args.Milestone == nil {
 		return nil, fmt.Errorf("no changes specified for pull request edit")
 	}
 
-	// Edit pull request using Gitea SDK
-	giteaPR, _, err := c.client.EditPullRequest(owner, repoName, int64(args.PullRequestNumber), editOptions)
-	if err != nil {
-		return nil, fmt.Errorf("failed to edit pull request: %w", err)
+	// The pull request endpoint cannot clear a milestone, so it is set through the issue endpoint
+	if args.Milestone != nil {
+		if err := c.setIssueMilestone(owner, repoName, args.PullRequestNumber, *args.Milestone); err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+	}
+
+	var giteaPR *gitea.PullRequest
+	var err error
+	if hasChanges {
+		// Edit pull request using Gitea SDK
+		giteaPR, _, err = c.client.EditPullRequest(owner, repoName, int64(args.PullRequestNumber), editOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+	} else {
+		// Only the milestone changed; fetch the updated pull request
+		giteaPR, _, err = c.client.GetPullRequest(owner, repoName, int64(args.PullRequestNumber))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", err)
+		}
 	}
 
 	// Convert to our PullRequest struct
@@ -459,6 +621,8 @@ func (c *GiteaClient) EditPullRequest(ctx context.Context, args remote.EditPullR
 		UpdatedAt: updatedAt,
 		Head:      head,
 		Base:      base,
+		Milestone: convertMilestone(giteaPR.Milestone),
+		Assignees: convertUserNames(giteaPR.Assignees),
 	}
 
 	return pr, nil
@@ -477,10 +641,15 @@ func (c *GiteaClient) CreateIssue(ctx context.Context, args remote.CreateIssueAr
 		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
 	}
 
+	if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
 	// Create issue using Gitea SDK
 	opts := gitea.CreateIssueOption{
-		Title: args.Title,
-		Body:  args.Body,
+		Title:     args.Title,
+		Body:      args.Body,
+		Assignees: args.Assignees,
 	}
 
 	giteaIssue, _, err := c.client.CreateIssue(owner, repoName, opts)
@@ -495,31 +664,14 @@ func (c *GiteaClient) CreateIssue(ctx context.Context, args remote.CreateIssueAr
 	}
 
 	issue := &remote.Issue{
-		ID:     int(giteaIssue.ID),
-		Number: int(giteaIssue.Index),
-		Title:  giteaIssue.Title,
-		State:  string(giteaIssue.State),
-		User:   author,
-	}
-
-	return issue, nil
-}
-
-// CreateIssueWithAttachments creates a new issue with attachments
-// Note: Attachment upload is not yet implemented as Forgejo/Gitea SDKs don't expose
-// issue attachment APIs. This method currently only creates the issue.
-func (c *GiteaClient) CreateIssueWithAttachments(ctx context.Context, args remote.CreateIssueWithAttachmentsArgs) (*remote.Issue, error) {
-	// First create the issue
-	issue, err := c.CreateIssue(ctx, args.CreateIssueArgs)
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO: Implement attachment upload once Forgejo/Gitea attachment APIs are documented
-	// For now, we create the issue but ignore attachments
-	if len(args.Attachments) > 0 {
-		// Log that attachments were provided but not uploaded
-		// In a real implementation, this would upload each attachment
+		ID:        int(giteaIssue.ID),
+		Number:    int(giteaIssue.Index),
+		Title:     giteaIssue.Title,
+		State:     string(giteaIssue.State),
+		User:      author,
+		Labels:    convertLabels(giteaIssue.Labels),
+		Milestone: convertMilestone(giteaIssue.Milestone),
+		Assignees: convertUserNames(giteaIssue.Assignees),
 	}
 
 	return issue, nil
@@ -571,6 +723,23 @@ func (c *GiteaClient) EditIssue(ctx context.Context, args remote.EditIssueArgs)
 		hasChanges = true
 	}
 
+	if args.Milestone != nil {
+		id, err := c.resolveMilestoneID(owner, repoName, *args.Milestone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit issue: %w", err)
+		}
+		editOptions.Milestone = &id
+		hasChanges = true
+	}
+
+	if args.Assignees != nil {
+		if err := c.validateUserAccess(ctx, owner, repoName, args.Assignees, "write"); err != nil {
+			return nil, fmt.Errorf("failed to edit issue: %w", err)
+		}
+		editOptions.Assignees = args.Assignees
+		hasChanges = true
+	}
+
 	if !hasChanges {
 		return nil, fmt.Errorf("no changes specified")
 	}
@@ -588,14 +757,17 @@ func (c *GiteaClient) EditIssue(ctx context.Context, args remote.EditIssueArgs)
 	}
 
 	issue := &remote.Issue{
-		ID:      int(giteaIssue.ID),
-		Number:  int(giteaIssue.Index),
-		Title:   giteaIssue.Title,
-		State:   string(giteaIssue.State),
-		Body:    giteaIssue.Body,
-		User:    author,
-		Updated: giteaIssue.Updated.Format("2006-01-02T15:04:05Z07:00"),
-		Created: giteaIssue.Created.Format("2006-01-02T15:04:05Z07:00"),
+		ID:        int(giteaIssue.ID),
+		Number:    int(giteaIssue.Index),
+		Title:     giteaIssue.Title,
+		State:     string(giteaIssue.State),
+		Body:      giteaIssue.Body,
+		User:      author,
+		Updated:   giteaIssue.Updated.Format("2006-01-02T15:04:05Z07:00"),
+		Created:   giteaIssue.Created.Format("2006-01-02T15:04:05Z07:00"),
+		Labels:    convertLabels(giteaIssue.Labels),
+		Milestone: convertMilestone(giteaIssue.Milestone),
+		Assignees: convertUserNames(giteaIssue.Assignees),
 	}
 
 	return issue, nil
@@ -619,12 +791,21 @@ func (c *GiteaClient) CreatePullRequest(ctx context.Context, args remote.CreateP
 		title = "[DRAFT] " + title
 	}
 
+	// Merge the single assignee into the assignee list
+	assignees := args.Assignees
+	if args.Assignee != "" && !slices.Contains(assignees, args.Assignee) {
+		assignees = append([]string{args.Assignee}, assignees...)
+	}
+	if err := c.validateUserAccess(ctx, owner, repoName, assignees, "write"); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
 	opts := gitea.CreatePullRequestOption{
-		Head:     args.Head,
-		Base:     args.Base,
-		Title:    title,
-		Body:     args.Body,
-		Assignee: args.Assignee,
+		Head:      args.Head,
+		Base:      args.Base,
+		Title:     title,
+		Body:      args.Body,
+		Assignees: assignees,
 	}
 
 	gpr, _, err := c.client.CreatePullRequest(owner, repoName, opts)
@@ -677,6 +858,7 @@ func (c *GiteaClient) CreatePullRequest(ctx context.Context, args remote.CreateP
 		UpdatedAt: updatedAt,
 		Head:      head,
 		Base:      base,
+		Assignees: convertUserNames(gpr.Assignees),
 	}, nil
 }
 
@@ -707,6 +889,58 @@ func (c *GiteaClient) GetPullRequest(ctx context.Context, repo string, number in
 	return c.convertToPullRequestDetails(giteaPR), nil
 }
 
+// MergePullRequest merges a pull request, or schedules the merge for when all status checks succeed
+func (c *GiteaClient) MergePullRequest(ctx context.Context, args remote.MergePullRequestArgs) (*remote.MergeResult, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	style := args.Style
+	if style == "" {
+		style = remote.MergeStyleMerge
+	}
+
+	opts := gitea.MergePullRequestOption{
+		Style:                  gitea.MergeStyle(style),
+		Title:                  args.Title,
+		Message:                args.Message,
+		DeleteBranchAfterMerge: args.DeleteBranchAfterMerge,
+		MergeWhenChecksSucceed: args.MergeWhenChecksSucceed,
+	}
+
+	// The SDK reports non-2xx responses through the status code rather than an error
+	_, resp, err := c.client.MergePullRequest(owner, repoName, int64(args.PullRequestNumber), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &remote.MergeResult{Merged: true, Style: style}, nil
+	case http.StatusCreated:
+		return &remote.MergeResult{Scheduled: true, Style: style}, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("pull request #%d not found in %s", args.PullRequestNumber, args.Repository)
+	case http.StatusMethodNotAllowed:
+		return nil, fmt.Errorf("pull request #%d is not mergeable (already merged, closed, conflicting, or blocked by branch protection)", args.PullRequestNumber)
+	case http.StatusConflict:
+		return nil, fmt.Errorf("merge conflict or %s merge not possible", style)
+	default:
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+}
+
 // convertToPullRequestDetails converts Gitea PR to our detailed format
 func (c *GiteaClient) convertToPullRequestDetails(gpr *gitea.PullRequest) *remote.PullRequestDetails {
 	// Extract user information
@@ -779,19 +1013,6 @@ func (c *GiteaClient) convertToPullRequestDetails(gpr *gitea.PullRequest) *remot
 		}
 	}
 
-	// Convert milestone
-	var milestone *remote.Milestone
-	if gpr.Milestone != nil {
-		milestone = &remote.Milestone{
-			ID:           int(gpr.Milestone.ID),
-			Title:        gpr.Milestone.Title,
-			Description:  gpr.Milestone.Description,
-			State:        string(gpr.Milestone.State),
-			OpenIssues:   int(gpr.Milestone.OpenIssues),
-			ClosedIssues: int(gpr.Milestone.ClosedIssues),
-		}
-	}
-
 	// Convert head branch
 	var head remote.PullRequestBranch
 	if gpr.Head != nil {
@@ -825,7 +1046,7 @@ func (c *GiteaClient) convertToPullRequestDetails(gpr *gitea.PullRequest) *remot
 		DiffURL:             gpr.DiffURL,
 		PatchURL:            gpr.PatchURL,
 		Labels:              labels,
-		Milestone:           milestone,
+		Milestone:           convertMilestone(gpr.Milestone),
 		Assignee:            assignee,
 		Assignees:           assignees,
 		Comments:            gpr.Comments,
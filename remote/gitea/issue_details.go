@@ -0,0 +1,109 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// GetIssue retrieves a single issue with its metadata and a summary of its reactions
+func (c *GiteaClient) GetIssue(ctx context.Context, repo string, number int) (*remote.IssueDetails, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if number <= 0 {
+		return nil, fmt.Errorf("invalid issue number: %d, must be positive", number)
+	}
+
+	issue, _, err := c.client.GetIssue(owner, repoName, int64(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	details := convertToIssueDetails(issue)
+
+	// Reactions are supplementary, so failing to load them must not fail the fetch
+	if reactions, _, err := c.client.GetIssueReactions(owner, repoName, int64(number)); err == nil {
+		details.Reactions = summarizeReactions(reactions)
+	}
+
+	return details, nil
+}
+
+// convertToIssueDetails converts a Gitea issue to our detailed format
+func convertToIssueDetails(issue *gitea.Issue) *remote.IssueDetails {
+	user := "unknown"
+	if issue.Poster != nil {
+		user = issue.Poster.UserName
+	}
+
+	details := &remote.IssueDetails{
+		ID:        int(issue.ID),
+		Number:    int(issue.Index),
+		Title:     issue.Title,
+		Body:      issue.Body,
+		State:     string(issue.State),
+		User:      user,
+		CreatedAt: issue.Created.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: issue.Updated.Format("2006-01-02T15:04:05Z"),
+		HTMLURL:   issue.HTMLURL,
+		Labels:    convertLabels(issue.Labels),
+		Milestone: convertMilestone(issue.Milestone),
+		Assignees: convertUserNames(issue.Assignees),
+		Comments:  issue.Comments,
+		IsLocked:  issue.IsLocked,
+	}
+
+	if issue.Closed != nil {
+		details.ClosedAt = issue.Closed.Format("2006-01-02T15:04:05Z")
+	}
+	if issue.Deadline != nil {
+		details.DueDate = issue.Deadline.Format("2006-01-02T15:04:05Z")
+	}
+
+	if issue.PullRequest != nil {
+		details.PullRequest = &remote.LinkedPullRequest{
+			Number:  int(issue.Index),
+			HTMLURL: issue.HTMLURL,
+			Merged:  issue.PullRequest.HasMerged,
+		}
+		if issue.PullRequest.Merged != nil {
+			details.PullRequest.MergedAt = issue.PullRequest.Merged.Format("2006-01-02T15:04:05Z")
+		}
+	}
+
+	return details
+}
+
+// summarizeReactions groups reactions by emoji, in order of first use
+func summarizeReactions(reactions []*gitea.Reaction) []remote.ReactionSummary {
+	var summaries []remote.ReactionSummary
+	index := map[string]int{}
+	for _, r := range reactions {
+		if r == nil {
+			continue
+		}
+		i, ok := index[r.Reaction]
+		if !ok {
+			i = len(summaries)
+			index[r.Reaction] = i
+			summaries = append(summaries, remote.ReactionSummary{Content: r.Reaction})
+		}
+		summaries[i].Count++
+		if r.User != nil {
+			summaries[i].Users = append(summaries[i].Users, r.User.UserName)
+		}
+	}
+	return summaries
+}
@@ -0,0 +1,297 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// labelPageSize is the page size used when loading every repository or organization label to resolve names
+const labelPageSize = 50
+
+// ListLabels lists the labels defined in a repository
+func (c *GiteaClient) ListLabels(ctx context.Context, repo string, limit, offset int) (*remote.LabelList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := gitea.ListLabelsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+		},
+	}
+
+	giteaLabels, _, err := c.client.ListRepoLabels(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	labels := convertLabels(giteaLabels)
+
+	// Note: Gitea SDK doesn't provide total count in ListRepoLabels response
+	return &remote.LabelList{
+		Labels: labels,
+		Total:  len(labels),
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// CreateLabel creates a new label in a repository
+func (c *GiteaClient) CreateLabel(ctx context.Context, args remote.CreateLabelArgs) (*remote.Label, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	label, _, err := c.client.CreateLabel(owner, repoName, gitea.CreateLabelOption{
+		Name:        args.Name,
+		Color:       args.Color,
+		Description: args.Description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	result := convertLabels([]*gitea.Label{label})[0]
+	return &result, nil
+}
+
+// EditLabel updates the name, color, or description of a repository label identified by name
+func (c *GiteaClient) EditLabel(ctx context.Context, args remote.EditLabelArgs) (*remote.Label, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	// Organization labels cannot be edited through the repository
+	labels, err := c.repoLabels(owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit label: %w", err)
+	}
+	ids, err := matchLabelIDs(owner, repoName, labels, []string{args.Name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit label: %w", err)
+	}
+
+	label, _, err := c.client.EditLabel(owner, repoName, ids[0], gitea.EditLabelOption{
+		Name:        args.NewName,
+		Color:       args.Color,
+		Description: args.Description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit label: %w", err)
+	}
+
+	result := convertLabels([]*gitea.Label{label})[0]
+	return &result, nil
+}
+
+// AddIssueLabels adds labels, by name, to an issue or pull request and returns its resulting labels
+func (c *GiteaClient) AddIssueLabels(ctx context.Context, repo string, issueNumber int, labels []string) ([]remote.Label, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if issueNumber <= 0 {
+		return nil, fmt.Errorf("invalid issue number: %d, must be positive", issueNumber)
+	}
+
+	ids, err := c.resolveLabelIDs(ctx, owner, repoName, labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	giteaLabels, _, err := c.client.AddIssueLabels(owner, repoName, int64(issueNumber), gitea.IssueLabelsOption{Labels: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	return convertLabels(giteaLabels), nil
+}
+
+// RemoveIssueLabels removes labels, by name, from an issue or pull request and returns its remaining labels
+func (c *GiteaClient) RemoveIssueLabels(ctx context.Context, repo string, issueNumber int, labels []string) ([]remote.Label, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if issueNumber <= 0 {
+		return nil, fmt.Errorf("invalid issue number: %d, must be positive", issueNumber)
+	}
+
+	ids, err := c.resolveLabelIDs(ctx, owner, repoName, labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove labels: %w", err)
+	}
+
+	for _, id := range ids {
+		if _, err := c.client.DeleteIssueLabel(owner, repoName, int64(issueNumber), id); err != nil {
+			return nil, fmt.Errorf("failed to remove labels: %w", err)
+		}
+	}
+
+	remaining, _, err := c.client.GetIssueLabels(owner, repoName, int64(issueNumber), gitea.ListLabelsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remaining labels: %w", err)
+	}
+
+	return convertLabels(remaining), nil
+}
+
+// resolveLabelIDs maps label names to the IDs of repository labels or, for repositories owned by an
+// organization, organization labels. Names match exactly, falling back to a case-insensitive match,
+// and repository labels take precedence; unknown names are reported together.
+func (c *GiteaClient) resolveLabelIDs(ctx context.Context, owner, repo string, names []string) ([]int64, error) {
+	labels, err := c.repoLabels(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	orgLabels, err := c.orgLabels(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return matchLabelIDs(owner, repo, append(labels, orgLabels...), names)
+}
+
+// repoLabels loads every label of a repository, paging until the reported total or an empty page
+func (c *GiteaClient) repoLabels(owner, repo string) ([]*gitea.Label, error) {
+	var all []*gitea.Label
+	for page := 1; ; page++ {
+		labels, resp, err := c.client.ListRepoLabels(owner, repo, gitea.ListLabelsOptions{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: labelPageSize},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", err)
+		}
+		all = append(all, labels...)
+		// The server may cap the page size below labelPageSize, so a short page is not the last one
+		if len(labels) == 0 {
+			return all, nil
+		}
+		if resp != nil {
+			if total, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil && len(all) >= total {
+				return all, nil
+			}
+		}
+	}
+}
+
+// orgLabels loads every label of an organization; owners that are users have none
+func (c *GiteaClient) orgLabels(ctx context.Context, owner string) ([]*gitea.Label, error) {
+	var all []*gitea.Label
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(labelPageSize))
+		body, status, err := c.apiGet(ctx, fmt.Sprintf("/orgs/%s/labels", url.PathEscape(owner)), query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization labels: %w", err)
+		}
+		switch status {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("failed to list organization labels: %s", apiErrorMessage(status, body))
+		}
+		var labels []*gitea.Label
+		if err := json.Unmarshal(body, &labels); err != nil {
+			return nil, fmt.Errorf("failed to list organization labels: invalid response: %w", err)
+		}
+		if len(labels) == 0 {
+			return all, nil
+		}
+		all = append(all, labels...)
+	}
+}
+
+// matchLabelIDs maps label names to the IDs of the given labels.
+// Names match exactly, falling back to a case-insensitive match; unknown names are reported together.
+func matchLabelIDs(owner, repo string, all []*gitea.Label, names []string) ([]int64, error) {
+	ids := make([]int64, 0, len(names))
+	var missing []string
+	for _, name := range names {
+		var match *gitea.Label
+		for _, label := range all {
+			if label.Name == name {
+				match = label
+				break
+			}
+			if match == nil && strings.EqualFold(label.Name, name) {
+				match = label
+			}
+		}
+		if match == nil {
+			missing = append(missing, name)
+			continue
+		}
+		ids = append(ids, match.ID)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("labels not found in %s/%s: %s", owner, repo, strings.Join(missing, ", "))
+	}
+	return ids, nil
+}
+
+// convertLabels converts Gitea labels to our Label struct, skipping nil entries
+func convertLabels(giteaLabels []*gitea.Label) []remote.Label {
+	labels := make([]remote.Label, 0, len(giteaLabels))
+	for _, l := range giteaLabels {
+		if l == nil {
+			continue
+		}
+		labels = append(labels, remote.Label{
+			ID:          int(l.ID),
+			Name:        l.Name,
+			Color:       l.Color,
+			Description: l.Description,
+		})
+	}
+	return labels
+}
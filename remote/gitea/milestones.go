@@ -0,0 +1,201 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// milestonePageSize is the page size used when loading every repository milestone to resolve titles
+const milestonePageSize = 50
+
+// ListMilestones lists the milestones of a repository filtered by state ("open", "closed", or "all")
+func (c *GiteaClient) ListMilestones(ctx context.Context, repo, state string, limit, offset int) (*remote.MilestoneList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := gitea.ListMilestoneOption{
+		ListOptions: gitea.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+		},
+		State: gitea.StateType(state),
+	}
+
+	giteaMilestones, _, err := c.client.ListRepoMilestones(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list milestones: %w", err)
+	}
+
+	milestones := make([]remote.Milestone, 0, len(giteaMilestones))
+	for _, m := range giteaMilestones {
+		if m != nil {
+			milestones = append(milestones, *convertMilestone(m))
+		}
+	}
+
+	// Note: Gitea SDK doesn't provide total count in ListRepoMilestones response
+	return &remote.MilestoneList{
+		Milestones: milestones,
+		Total:      len(milestones),
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
+
+// CreateMilestone creates a new milestone in a repository
+func (c *GiteaClient) CreateMilestone(ctx context.Context, args remote.CreateMilestoneArgs) (*remote.Milestone, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	milestone, _, err := c.client.CreateMilestone(owner, repoName, gitea.CreateMilestoneOption{
+		Title:       args.Title,
+		Description: args.Description,
+		Deadline:    args.DueDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create milestone: %w", err)
+	}
+
+	return convertMilestone(milestone), nil
+}
+
+// EditMilestone updates the title, description, due date, or state of a milestone identified by title
+func (c *GiteaClient) EditMilestone(ctx context.Context, args remote.EditMilestoneArgs) (*remote.Milestone, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	id, err := c.resolveMilestoneID(owner, repoName, args.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit milestone: %w", err)
+	}
+
+	// Prepare edit options - only include fields that are provided
+	opts := gitea.EditMilestoneOption{
+		Description: args.Description,
+		Deadline:    args.DueDate,
+	}
+	if args.NewTitle != nil {
+		opts.Title = *args.NewTitle
+	}
+	if args.State != nil {
+		var state gitea.StateType
+		switch *args.State {
+		case "open":
+			state = gitea.StateOpen
+		case "closed":
+			state = gitea.StateClosed
+		default:
+			return nil, fmt.Errorf("invalid state: %s, must be 'open' or 'closed'", *args.State)
+		}
+		opts.State = &state
+	}
+
+	milestone, _, err := c.client.EditMilestone(owner, repoName, id, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit milestone: %w", err)
+	}
+
+	return convertMilestone(milestone), nil
+}
+
+// resolveMilestoneID maps a milestone title to its ID, searching open and closed milestones.
+// Titles match exactly, falling back to a case-insensitive match. An empty title resolves to 0,
+// which clears the milestone when used in an issue edit.
+func (c *GiteaClient) resolveMilestoneID(owner, repo, title string) (int64, error) {
+	if title == "" {
+		return 0, nil
+	}
+
+	var match *gitea.Milestone
+	for page := 1; ; page++ {
+		milestones, _, err := c.client.ListRepoMilestones(owner, repo, gitea.ListMilestoneOption{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: milestonePageSize},
+			State:       gitea.StateAll,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list milestones: %w", err)
+		}
+		for _, m := range milestones {
+			if m == nil {
+				continue
+			}
+			if m.Title == title {
+				return m.ID, nil
+			}
+			if match == nil && strings.EqualFold(m.Title, title) {
+				match = m
+			}
+		}
+		if len(milestones) < milestonePageSize {
+			break
+		}
+	}
+
+	if match == nil {
+		return 0, fmt.Errorf("milestone not found in %s/%s: %s", owner, repo, title)
+	}
+	return match.ID, nil
+}
+
+// setIssueMilestone sets the milestone of an issue or pull request by title, clearing it when the title is empty
+func (c *GiteaClient) setIssueMilestone(owner, repo string, number int, title string) error {
+	id, err := c.resolveMilestoneID(owner, repo, title)
+	if err != nil {
+		return err
+	}
+	if _, _, err := c.client.EditIssue(owner, repo, int64(number), gitea.EditIssueOption{Milestone: &id}); err != nil {
+		return fmt.Errorf("failed to set milestone: %w", err)
+	}
+	return nil
+}
+
+// convertMilestone converts a Gitea milestone to our Milestone struct, returning nil for nil input
+func convertMilestone(m *gitea.Milestone) *remote.Milestone {
+	if m == nil {
+		return nil
+	}
+	milestone := &remote.Milestone{
+		ID:           int(m.ID),
+		Title:        m.Title,
+		Description:  m.Description,
+		State:        string(m.State),
+		OpenIssues:   m.OpenIssues,
+		ClosedIssues: m.ClosedIssues,
+	}
+	if m.Deadline != nil {
+		milestone.DueDate = m.Deadline.Format("2006-01-02T15:04:05Z")
+	}
+	return milestone
+}
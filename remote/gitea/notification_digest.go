@@ -0,0 +1,250 @@
+package gitea
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// notificationPageSize is the page size used to read all unread notifications and the issues
+// that involve the authenticated user
+const notificationPageSize = 50
+
+// notificationLookupWorkers bounds the concurrent subject lookups of EnrichNotificationDetails
+const notificationLookupWorkers = 4
+
+// involvementMargin widens the window of the involvement searches, because a notification is
+// updated just after the issue or pull request it is about
+const involvementMargin = time.Hour
+
+// involvementReasons maps the issue search involvement filters to the reasons they reveal
+var involvementReasons = []struct{ filter, reason string }{
+	{"review_requested", remote.NotificationReasonReviewRequested},
+	{"mentioned", remote.NotificationReasonMention},
+	{"assigned", remote.NotificationReasonAssigned},
+}
+
+// ListUnreadNotificationDetails lists all unread notifications, optionally limited to one repository,
+// with the reasons they concern the authenticated user. The reasons come from one issue search per
+// involvement filter rather than from each subject, which is left to EnrichNotificationDetails.
+func (c *GiteaClient) ListUnreadNotificationDetails(ctx context.Context, repo string) ([]remote.NotificationDetails, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	path := "/notifications"
+	if repo != "" {
+		owner, repoName, ok := strings.Cut(repo, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+		}
+		path = fmt.Sprintf("/repos/%s/%s/notifications", url.PathEscape(owner), url.PathEscape(repoName))
+	}
+
+	var threads []*gitea.NotificationThread
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("status-types", string(gitea.NotifyStatusUnread))
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(notificationPageSize))
+
+		var batch []*gitea.NotificationThread
+		if err := c.apiGetJSON(ctx, path, query, &batch); err != nil {
+			return nil, fmt.Errorf("failed to list notifications: %w", err)
+		}
+		threads = append(threads, batch...)
+		if len(batch) < notificationPageSize {
+			break
+		}
+	}
+	if len(threads) == 0 {
+		return []remote.NotificationDetails{}, nil
+	}
+
+	since := slices.MinFunc(threads, func(a, b *gitea.NotificationThread) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	}).UpdatedAt.Add(-involvementMargin)
+	reasons, err := c.involvementReasons(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]remote.NotificationDetails, len(threads))
+	for i, thread := range threads {
+		details[i] = remote.NotificationDetails{Notification: convertToNotification(thread)}
+		if thread.Subject != nil {
+			details[i].State = string(thread.Subject.State)
+			details[i].HTMLURL = thread.Subject.HTMLURL
+			details[i].LatestCommentID = int(commentIDFromURL(thread.Subject.LatestCommentURL))
+		}
+		if details[i].Type == "issue" || details[i].Type == "pull" {
+			details[i].Reasons = slices.Clone(reasons[fmt.Sprintf("%s#%d", details[i].Repository, details[i].Number)])
+		}
+		if len(details[i].Reasons) == 0 {
+			details[i].Reasons = []string{remote.NotificationReasonSubscribed}
+		}
+	}
+	return details, nil
+}
+
+// involvementReasons searches the issues and pull requests updated since the given time that involve
+// the authenticated user, returning their reasons keyed by "owner/repo#number"
+func (c *GiteaClient) involvementReasons(ctx context.Context, since time.Time) (map[string][]string, error) {
+	reasons := map[string][]string{}
+	for _, involvement := range involvementReasons {
+		for page := 1; ; page++ {
+			query := url.Values{}
+			query.Set("state", "all")
+			query.Set(involvement.filter, "true")
+			query.Set("since", since.Format(time.RFC3339))
+			query.Set("page", strconv.Itoa(page))
+			query.Set("limit", strconv.Itoa(notificationPageSize))
+
+			var issues []*gitea.Issue
+			if err := c.apiGetJSON(ctx, "/repos/issues/search", query, &issues); err != nil {
+				return nil, fmt.Errorf("failed to search issues %s to the user: %w", strings.ReplaceAll(involvement.filter, "_", " "), err)
+			}
+			for _, issue := range issues {
+				if issue.Repository != nil {
+					key := fmt.Sprintf("%s#%d", issue.Repository.FullName, issue.Index)
+					reasons[key] = append(reasons[key], involvement.reason)
+				}
+			}
+			if len(issues) < notificationPageSize {
+				break
+			}
+		}
+	}
+	return reasons, nil
+}
+
+// EnrichNotificationDetails looks up the subjects of issue and pull request notifications with a
+// bounded number of concurrent requests, adding the latest comment and the CI state of pull requests
+// along with the reasons they reveal. Lookup errors are ignored so that a deleted issue or a missing
+// permission does not hide the notification; only a failure to identify the user or ctx is returned.
+func (c *GiteaClient) EnrichNotificationDetails(ctx context.Context, details []remote.NotificationDetails) error {
+	// Check if client is initialized
+	if c.client == nil {
+		return fmt.Errorf("client not initialized")
+	}
+
+	var me gitea.User
+	if err := c.apiGetJSON(ctx, "/user", nil, &me); err != nil {
+		return fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	mention := mentionRegexp(me.UserName)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, notificationLookupWorkers)
+	for i := range details {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			c.enrichNotification(ctx, &details[i], me.UserName, mention)
+		})
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// enrichNotification looks up the subject of an issue or pull request notification
+func (c *GiteaClient) enrichNotification(ctx context.Context, details *remote.NotificationDetails, user string, mention *regexp.Regexp) {
+	owner, repoName, ok := strings.Cut(details.Repository, "/")
+	isThread := details.Type == "issue" || details.Type == "pull"
+	if !ok || !isThread || details.Number <= 0 {
+		return
+	}
+	repoPath := fmt.Sprintf("/repos/%s/%s", url.PathEscape(owner), url.PathEscape(repoName))
+
+	if details.State == "" || details.HTMLURL == "" {
+		var issue gitea.Issue
+		if err := c.apiGetJSON(ctx, fmt.Sprintf("%s/issues/%d", repoPath, details.Number), nil, &issue); err == nil {
+			details.State = cmp.Or(details.State, string(issue.State))
+			details.HTMLURL = cmp.Or(details.HTMLURL, issue.HTMLURL)
+		}
+	}
+
+	if details.Type == "pull" {
+		details.CIState = c.pullRequestCIState(ctx, repoPath, details.Number)
+		if details.CIState == string(gitea.StatusFailure) || details.CIState == string(gitea.StatusError) {
+			addNotificationReason(details, remote.NotificationReasonCI)
+		}
+	}
+
+	if details.LatestCommentID > 0 {
+		var comment gitea.Comment
+		if err := c.apiGetJSON(ctx, fmt.Sprintf("%s/issues/comments/%d", repoPath, details.LatestCommentID), nil, &comment); err == nil {
+			if comment.Poster != nil {
+				details.LatestCommentAuthor = comment.Poster.UserName
+			}
+			details.LatestComment = comment.Body
+			if !strings.EqualFold(details.LatestCommentAuthor, user) && mention.MatchString(comment.Body) {
+				addNotificationReason(details, remote.NotificationReasonMention)
+			}
+		}
+	}
+}
+
+// pullRequestCIState returns the combined status of the head commit of a pull request, or an empty
+// string when it cannot be read or the commit has no status checks
+func (c *GiteaClient) pullRequestCIState(ctx context.Context, repoPath string, number int) string {
+	var pr struct {
+		Head struct {
+			Sha string `json:"sha"`
+		} `json:"head"`
+	}
+	if err := c.apiGetJSON(ctx, fmt.Sprintf("%s/pulls/%d", repoPath, number), nil, &pr); err != nil || pr.Head.Sha == "" {
+		return ""
+	}
+
+	var combined gitea.CombinedStatus
+	if err := c.apiGetJSON(ctx, fmt.Sprintf("%s/commits/%s/status", repoPath, url.PathEscape(pr.Head.Sha)), nil, &combined); err != nil || combined.TotalCount == 0 {
+		return ""
+	}
+	return string(combined.State)
+}
+
+// addNotificationReason adds a reason revealed by a subject lookup, replacing the subscribed fallback
+func addNotificationReason(details *remote.NotificationDetails, reason string) {
+	if slices.Equal(details.Reasons, []string{remote.NotificationReasonSubscribed}) {
+		details.Reasons = nil
+	}
+	if !slices.Contains(details.Reasons, reason) {
+		details.Reasons = append(details.Reasons, reason)
+	}
+}
+
+// mentionRegexp matches an @-mention of the user that is not part of a longer name or address
+func mentionRegexp(user string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(^|[^\w@])@` + regexp.QuoteMeta(user) + `([^\w-]|$)`)
+}
+
+// commentIDFromURL extracts the comment ID from a notification's latest comment API URL
+func commentIDFromURL(url string) int64 {
+	// Pattern: /repos/owner/repo/issues/comments/123
+	_, idStr, ok := strings.Cut(url, "/issues/comments/")
+	if !ok {
+		return 0
+	}
+	id, err := strconv.ParseInt(strings.TrimRight(idStr, "/"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,96 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// GetPullRequestDiff fetches the unified diff of a pull request
+func (c *GiteaClient) GetPullRequestDiff(ctx context.Context, repo string, pullRequestNumber int) (string, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return "", fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return "", fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+
+	diff, _, err := c.client.GetPullRequestDiff(owner, repoName, int64(pullRequestNumber), gitea.PullRequestDiffOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+
+	return string(diff), nil
+}
+
+// ListPullRequestFiles lists the files changed by a pull request
+func (c *GiteaClient) ListPullRequestFiles(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*remote.PullRequestFileList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := gitea.ListPullRequestFilesOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+		},
+	}
+
+	giteaFiles, resp, err := c.client.ListPullRequestFiles(owner, repoName, int64(pullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request files: %w", err)
+	}
+
+	files := make([]remote.ChangedFile, len(giteaFiles))
+	for i, gf := range giteaFiles {
+		files[i] = remote.ChangedFile{
+			Filename:         gf.Filename,
+			PreviousFilename: gf.PreviousFilename,
+			Status:           gf.Status,
+			Additions:        gf.Additions,
+			Deletions:        gf.Deletions,
+			Changes:          gf.Changes,
+		}
+	}
+
+	// Gitea reports the number of changed files in X-Total-Count; fall back to the page size
+	total := offset + len(files)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.PullRequestFileList{
+		Files:  files,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
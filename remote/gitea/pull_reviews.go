@@ -0,0 +1,179 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// CreatePullRequestReview submits a review with optional inline comments on a pull request
+func (c *GiteaClient) CreatePullRequestReview(ctx context.Context, args remote.CreatePullRequestReviewArgs) (*remote.PullRequestReview, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(args.Repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", args.Repository)
+	}
+
+	if args.PullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", args.PullRequestNumber)
+	}
+
+	comments := make([]gitea.CreatePullReviewComment, len(args.Comments))
+	for i, comment := range args.Comments {
+		comments[i] = gitea.CreatePullReviewComment{
+			Path:       comment.Path,
+			Body:       comment.Body,
+			NewLineNum: int64(comment.NewLine),
+			OldLineNum: int64(comment.OldLine),
+		}
+	}
+
+	opts := gitea.CreatePullReviewOptions{
+		State:    gitea.ReviewStateType(args.State),
+		Body:     args.Body,
+		CommitID: args.CommitID,
+		Comments: comments,
+	}
+
+	review, _, err := c.client.CreatePullReview(owner, repoName, int64(args.PullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request review: %w", err)
+	}
+
+	result := convertPullReview(review)
+	return &result, nil
+}
+
+// ListPullRequestReviews lists the reviews submitted on a pull request
+func (c *GiteaClient) ListPullRequestReviews(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*remote.PullRequestReviewList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := gitea.ListPullReviewsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+		},
+	}
+
+	giteaReviews, _, err := c.client.ListPullReviews(owner, repoName, int64(pullRequestNumber), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request reviews: %w", err)
+	}
+
+	reviews := make([]remote.PullRequestReview, len(giteaReviews))
+	for i, review := range giteaReviews {
+		reviews[i] = convertPullReview(review)
+	}
+
+	// Note: Gitea SDK doesn't provide total count in ListPullReviews response
+	return &remote.PullRequestReviewList{
+		Reviews: reviews,
+		Total:   len(reviews),
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// ListPullRequestReviewComments lists the inline comments attached to a pull request review
+func (c *GiteaClient) ListPullRequestReviewComments(ctx context.Context, repo string, pullRequestNumber, reviewID int) ([]remote.PullRequestReviewComment, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if pullRequestNumber <= 0 {
+		return nil, fmt.Errorf("invalid pull request number: %d, must be positive", pullRequestNumber)
+	}
+	if reviewID <= 0 {
+		return nil, fmt.Errorf("invalid review ID: %d, must be positive", reviewID)
+	}
+
+	giteaComments, _, err := c.client.ListPullReviewComments(owner, repoName, int64(pullRequestNumber), int64(reviewID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request review comments: %w", err)
+	}
+
+	comments := make([]remote.PullRequestReviewComment, len(giteaComments))
+	for i, gc := range giteaComments {
+		author := "unknown"
+		if gc.Reviewer != nil {
+			author = gc.Reviewer.UserName
+		}
+		comments[i] = remote.PullRequestReviewComment{
+			ID:       int(gc.ID),
+			ReviewID: int(gc.ReviewID),
+			Body:     gc.Body,
+			Author:   author,
+			Path:     gc.Path,
+			Line:     int(gc.LineNum),
+			OldLine:  int(gc.OldLineNum),
+			CommitID: gc.CommitID,
+			DiffHunk: gc.DiffHunk,
+			Resolved: gc.Resolver != nil,
+			Created:  gc.Created.Format("2006-01-02T15:04:05Z"),
+			Updated:  gc.Updated.Format("2006-01-02T15:04:05Z"),
+			HTMLURL:  gc.HTMLURL,
+		}
+	}
+
+	return comments, nil
+}
+
+// convertPullReview converts a Gitea review to our PullRequestReview struct
+func convertPullReview(review *gitea.PullReview) remote.PullRequestReview {
+	reviewer := "unknown"
+	if review.Reviewer != nil {
+		reviewer = review.Reviewer.UserName
+	} else if review.ReviewerTeam != nil {
+		reviewer = review.ReviewerTeam.Name
+	}
+
+	submitted := ""
+	if !review.Submitted.IsZero() {
+		submitted = review.Submitted.Format("2006-01-02T15:04:05Z")
+	}
+
+	return remote.PullRequestReview{
+		ID:            int(review.ID),
+		Reviewer:      reviewer,
+		State:         string(review.State),
+		Body:          review.Body,
+		CommitID:      review.CommitID,
+		Stale:         review.Stale,
+		Official:      review.Official,
+		Dismissed:     review.Dismissed,
+		CommentsCount: review.CodeCommentsCount,
+		Submitted:     submitted,
+		HTMLURL:       review.HTMLURL,
+	}
+}
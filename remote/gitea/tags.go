@@ -0,0 +1,103 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/kunde21/forgejo-mcp/remote"
+)
+
+// ListTags lists the git tags of a repository, newest first
+func (c *GiteaClient) ListTags(ctx context.Context, repo string, limit, offset int) (*remote.TagList, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	opts := gitea.ListRepoTagsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: limit,
+			Page:     offset/limit + 1, // Gitea uses 1-based pagination
+		},
+	}
+
+	giteaTags, resp, err := c.client.ListRepoTags(owner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := make([]remote.Tag, 0, len(giteaTags))
+	for _, tag := range giteaTags {
+		if tag != nil {
+			tags = append(tags, convertTag(tag))
+		}
+	}
+
+	// Gitea reports the number of tags in X-Total-Count; fall back to the page size
+	total := offset + len(tags)
+	if resp != nil {
+		if count, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			total = count
+		}
+	}
+
+	return &remote.TagList{
+		Tags:   tags,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// CreateTag creates a tag at a branch or commit SHA, or at the default branch when target is
+// empty. A message makes it an annotated tag.
+func (c *GiteaClient) CreateTag(ctx context.Context, repo, name, target, message string) (*remote.Tag, error) {
+	// Check if client is initialized
+	if c.client == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	// Parse repository string (format: "owner/repo")
+	owner, repoName, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository format: %s, expected 'owner/repo'", repo)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+
+	tag, _, err := c.client.CreateTag(owner, repoName, gitea.CreateTagOption{
+		TagName: name,
+		Target:  target,
+		Message: message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+
+	result := convertTag(tag)
+	return &result, nil
+}
+
+// convertTag converts an SDK tag to the interface type
+func convertTag(tag *gitea.Tag) remote.Tag {
+	result := remote.Tag{Name: tag.Name, Message: tag.Message}
+	if tag.Commit != nil {
+		result.CommitSHA = tag.Commit.SHA
+	}
+	return result
+}
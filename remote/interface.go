@@ -2,23 +2,107 @@ package remote
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
+// ErrUnsupported is returned when the remote server or client type does not provide an operation
+var ErrUnsupported = errors.New("operation not supported by this remote")
+
+// ErrNotCommentAuthor is returned when deleting a comment written by another user without allowing it
+var ErrNotCommentAuthor = errors.New("comment was not written by the authenticated user")
+
+// ErrFileConflict is returned when a file write or delete is based on a file version that is no longer current
+var ErrFileConflict = errors.New("file changed since it was read")
+
 // Issue represents a Git repository issue
 type Issue struct {
-	ID      int    `json:"id"`
-	Number  int    `json:"number"`
-	Title   string `json:"title"`
-	State   string `json:"state"`
-	Body    string `json:"body,omitempty"`
-	User    string `json:"user"`
-	Updated string `json:"updated,omitempty"`
-	Created string `json:"created,omitempty"`
+	ID        int        `json:"id"`
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	Body      string     `json:"body,omitempty"`
+	User      string     `json:"user"`
+	Updated   string     `json:"updated,omitempty"`
+	Created   string     `json:"created,omitempty"`
+	Labels    []Label    `json:"labels,omitempty"`
+	Milestone *Milestone `json:"milestone,omitempty"`
+	Assignees []string   `json:"assignees,omitempty"`
+
+	// Set by cross-repository search results
+	Repository  string `json:"repository,omitempty"`   // Repository in "owner/repo" format
+	PullRequest bool   `json:"pull_request,omitempty"` // True when the issue is a pull request
+}
+
+// Issue sort orders accepted by ListIssuesOptions
+const (
+	IssueSortLatest       = "latest"       // Newest first
+	IssueSortOldest       = "oldest"       // Oldest first
+	IssueSortRecentUpdate = "recentupdate" // Most recently updated first
+	IssueSortLeastUpdate  = "leastupdate"  // Least recently updated first
+	IssueSortMostComment  = "mostcomment"  // Most comments first
+	IssueSortLeastComment = "leastcomment" // Fewest comments first
+	IssueSortNearDueDate  = "nearduedate"  // Nearest due date first
+	IssueSortFarDueDate   = "farduedate"   // Farthest due date first
+)
+
+// ListIssuesOptions represents the filters, sort order, and pagination for listing issues.
+// Zero values leave a filter unset.
+type ListIssuesOptions struct {
+	State     string    `json:"state"`     // "open", "closed", or "all"; defaults to "open"
+	Type      string    `json:"type"`      // "issues" or "pulls"; empty lists both
+	Labels    []string  `json:"labels"`    // Label names
+	Milestone string    `json:"milestone"` // Milestone title
+	Assignee  string    `json:"assignee"`  // Username assigned to the issue
+	Author    string    `json:"author"`    // Username that opened the issue
+	Mentioned string    `json:"mentioned"` // Username mentioned in the issue
+	Query     string    `json:"query"`     // Keyword matched against title and body
+	Since     time.Time `json:"since"`     // Only issues updated at or after this time
+	Before    time.Time `json:"before"`    // Only issues updated before this time
+	Sort      string    `json:"sort"`      // One of the IssueSort constants; empty uses the server default (newest first)
+	Limit     int       `json:"limit"`
+	Offset    int       `json:"offset"`
 }
 
 // IssueLister defines the interface for listing issues from a Git repository
 type IssueLister interface {
-	ListIssues(ctx context.Context, repo string, limit, offset int) ([]Issue, error)
+	ListIssues(ctx context.Context, repo string, options ListIssuesOptions) ([]Issue, error)
+}
+
+// IssueGetter defines the interface for fetching a single issue
+type IssueGetter interface {
+	GetIssue(ctx context.Context, repo string, number int) (*IssueDetails, error)
+}
+
+// Involvement filters accepted by SearchIssuesOptions, relative to the authenticated user
+const (
+	InvolvementAssigned        = "assigned"         // Issues assigned to the user
+	InvolvementCreated         = "created"          // Issues opened by the user
+	InvolvementMentioned       = "mentioned"        // Issues mentioning the user
+	InvolvementReviewRequested = "review_requested" // Pull requests requesting the user's review
+	InvolvementReviewed        = "reviewed"         // Pull requests the user has reviewed
+)
+
+// SearchIssuesOptions represents the filters and pagination for searching issues across
+// all repositories visible to the authenticated user. Zero values leave a filter unset.
+type SearchIssuesOptions struct {
+	State       string    `json:"state"`       // "open", "closed", or "all"; defaults to "open"
+	Type        string    `json:"type"`        // "issues" or "pulls"; empty searches both
+	Owner       string    `json:"owner"`       // Limit to repositories of this user or organization
+	Team        string    `json:"team"`        // Limit to repositories of this team; requires Owner
+	Involvement string    `json:"involvement"` // One of the Involvement constants
+	Labels      []string  `json:"labels"`      // Label names
+	Milestone   string    `json:"milestone"`   // Milestone title
+	Query       string    `json:"query"`       // Keyword matched against title and body
+	Since       time.Time `json:"since"`       // Only issues updated at or after this time
+	Before      time.Time `json:"before"`      // Only issues updated before this time
+	Limit       int       `json:"limit"`
+	Offset      int       `json:"offset"`
+}
+
+// IssueSearcher defines the interface for searching issues across repositories
+type IssueSearcher interface {
+	SearchIssues(ctx context.Context, options SearchIssuesOptions) ([]Issue, error)
 }
 
 // Comment represents a comment on a Git repository issue or pull request
@@ -28,6 +112,8 @@ type Comment struct {
 	Author  string `json:"user"`
 	Created string `json:"created"`
 	Updated string `json:"updated"`
+
+	Reactions []ReactionSummary `json:"reactions,omitempty"` // Set by comment listings
 }
 
 // IssueCommenter defines the interface for creating comments on Git repository issues
@@ -56,6 +142,48 @@ type IssueCommentLister interface {
 	ListIssueComments(ctx context.Context, repo string, issueNumber int, limit, offset int) (*IssueCommentList, error)
 }
 
+// Timeline event categories reported by TimelineEvent.Category
+const (
+	TimelineCategoryComment       = "comment"        // A comment
+	TimelineCategoryLabel         = "label"          // A label was added or removed
+	TimelineCategoryMilestone     = "milestone"      // The milestone was set, changed, or removed
+	TimelineCategoryAssignee      = "assignee"       // An assignee was added or removed
+	TimelineCategoryState         = "state"          // The issue was closed, reopened, or merged
+	TimelineCategoryReference     = "reference"      // A commit, issue, or pull request referenced the issue
+	TimelineCategoryReviewRequest = "review_request" // A review was requested or the request removed
+	TimelineCategoryReview        = "review"         // A pull request review was submitted
+	TimelineCategoryTitle         = "title"          // The title was changed
+	TimelineCategoryOther         = "other"          // Any other event, see TimelineEvent.Type
+)
+
+// TimelineEvent represents a comment or system event on an issue or pull request
+type TimelineEvent struct {
+	ID       int    `json:"id"`
+	Type     string `json:"type"`     // Raw event type reported by the server, e.g. "close" or "commit_ref"
+	Category string `json:"category"` // One of the TimelineCategory constants
+	Actor    string `json:"actor"`
+	Created  string `json:"created"`
+	Summary  string `json:"summary"` // Human-readable description, e.g. "added label bug"
+
+	// Event details, set depending on the category
+	Body         string `json:"body,omitempty"`          // Comment or review text
+	Action       string `json:"action,omitempty"`        // "added", "removed", "changed", "closed", "reopened", or "merged"
+	Label        string `json:"label,omitempty"`         // Label name
+	Milestone    string `json:"milestone,omitempty"`     // New milestone title
+	OldMilestone string `json:"old_milestone,omitempty"` // Previous milestone title
+	Assignee     string `json:"assignee,omitempty"`      // Assignee username
+	Reviewer     string `json:"reviewer,omitempty"`      // Requested reviewer, teams prefixed with "team "
+	RefIssue     string `json:"ref_issue,omitempty"`     // Referencing issue or pull request as "owner/repo#number"
+	RefCommit    string `json:"ref_commit,omitempty"`    // Referencing commit SHA
+	OldTitle     string `json:"old_title,omitempty"`
+	NewTitle     string `json:"new_title,omitempty"`
+}
+
+// IssueTimelineReader defines the interface for reading the timeline of an issue or pull request
+type IssueTimelineReader interface {
+	ListIssueTimeline(ctx context.Context, repo string, issueNumber int, limit, offset int) ([]TimelineEvent, error)
+}
+
 // EditIssueCommentArgs represents the arguments for editing an issue comment
 type EditIssueCommentArgs struct {
 	Repository  string `json:"repository"`
@@ -69,11 +197,27 @@ type IssueCommentEditor interface {
 	EditIssueComment(ctx context.Context, args EditIssueCommentArgs) (*Comment, error)
 }
 
+// DeleteCommentArgs represents the arguments for deleting an issue or pull request comment
+type DeleteCommentArgs struct {
+	Repository  string `json:"repository"`
+	IssueNumber int    `json:"issue_number"` // Issue or pull request the comment must belong to; 0 skips the check
+	CommentID   int    `json:"comment_id"`
+	AllowOthers bool   `json:"allow_others"` // Allow deleting comments written by other users
+}
+
+// CommentDeleter defines the interface for deleting comments on issues and pull requests.
+// Unless AllowOthers is set, comments not written by the authenticated user are refused
+// with an error wrapping ErrNotCommentAuthor.
+type CommentDeleter interface {
+	DeleteComment(ctx context.Context, args DeleteCommentArgs) error
+}
+
 // CreateIssueArgs represents arguments for creating a new issue
 type CreateIssueArgs struct {
-	Repository string `json:"repository"`
-	Title      string `json:"title"`
-	Body       string `json:"body"`
+	Repository string   `json:"repository"`
+	Title      string   `json:"title"`
+	Body       string   `json:"body"`
+	Assignees  []string `json:"assignees,omitempty"` // Usernames to assign
 }
 
 // IssueCreator defines the interface for creating issues
@@ -81,12 +225,6 @@ type IssueCreator interface {
 	CreateIssue(ctx context.Context, args CreateIssueArgs) (*Issue, error)
 }
 
-// CreateIssueWithAttachmentsArgs represents arguments for creating a new issue with attachments
-type CreateIssueWithAttachmentsArgs struct {
-	CreateIssueArgs
-	Attachments []ProcessedAttachment
-}
-
 // ProcessedAttachment represents a processed file attachment
 type ProcessedAttachment struct {
 	Data     []byte
@@ -94,19 +232,49 @@ type ProcessedAttachment struct {
 	MIMEType string
 }
 
-// IssueAttachmentCreator defines the interface for creating issues with attachments
-type IssueAttachmentCreator interface {
-	CreateIssueWithAttachments(ctx context.Context, args CreateIssueWithAttachmentsArgs) (*Issue, error)
+// Attachment represents a file attached to an issue, pull request or comment
+type Attachment struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	UUID        string `json:"uuid,omitempty"`
+	DownloadURL string `json:"download_url"`
+	Created     string `json:"created,omitempty"`
+}
+
+// AttachmentUploader defines the interface for uploading files to issues, pull requests and comments.
+// Pull requests share the issue endpoints and are addressed by their number.
+type AttachmentUploader interface {
+	UploadIssueAttachment(ctx context.Context, repo string, number int, file ProcessedAttachment) (*Attachment, error)
+	UploadCommentAttachment(ctx context.Context, repo string, commentID int, file ProcessedAttachment) (*Attachment, error)
+}
+
+// IssueOrCommentTarget identifies an issue or pull request, or a single comment on one, that
+// reactions and attached files belong to. Exactly one of IssueNumber or CommentID is set.
+type IssueOrCommentTarget struct {
+	Repository  string `json:"repository"`
+	IssueNumber int    `json:"issue_number,omitempty"` // Issue or pull request number
+	CommentID   int    `json:"comment_id,omitempty"`
+}
+
+// AttachmentReader defines the interface for listing and downloading attached files.
+// DownloadAttachment fails without reading further once the file exceeds maxSize bytes.
+type AttachmentReader interface {
+	ListAttachments(ctx context.Context, target IssueOrCommentTarget) ([]Attachment, error)
+	GetAttachment(ctx context.Context, target IssueOrCommentTarget, id int) (*Attachment, error)
+	DownloadAttachment(ctx context.Context, attachment *Attachment, maxSize int64) ([]byte, error)
 }
 
 // EditIssueArgs represents the arguments for editing an issue
 type EditIssueArgs struct {
-	Repository  string `json:"repository"`
-	Directory   string `json:"directory"`
-	IssueNumber int    `json:"issue_number"`
-	Title       string `json:"title"`
-	Body        string `json:"body"`
-	State       string `json:"state"`
+	Repository  string   `json:"repository"`
+	Directory   string   `json:"directory"`
+	IssueNumber int      `json:"issue_number"`
+	Title       string   `json:"title"`
+	Body        string   `json:"body"`
+	State       string   `json:"state"`
+	Milestone   *string  `json:"milestone,omitempty"` // Milestone title; empty clears the milestone, nil leaves it unchanged
+	Assignees   []string `json:"assignees,omitempty"` // Replaces the assignees; empty clears them, nil leaves them unchanged
 }
 
 // IssueEditor defines the interface for editing issues in Git repositories
@@ -132,6 +300,8 @@ type PullRequest struct {
 	UpdatedAt string            `json:"updated"`
 	Head      PullRequestBranch `json:"head"`
 	Base      PullRequestBranch `json:"base"`
+	Milestone *Milestone        `json:"milestone,omitempty"`
+	Assignees []string          `json:"assignees,omitempty"`
 }
 
 // ListPullRequestsOptions represents the options for listing pull requests
@@ -194,13 +364,15 @@ type PullRequestCommentEditor interface {
 
 // EditPullRequestArgs represents the arguments for editing a pull request
 type EditPullRequestArgs struct {
-	Repository        string `json:"repository"`
-	Directory         string `json:"directory"`
-	PullRequestNumber int    `json:"pull_request_number"`
-	Title             string `json:"title"`
-	Body              string `json:"body"`
-	State             string `json:"state"`
-	BaseBranch        string `json:"base_branch"`
+	Repository        string   `json:"repository"`
+	Directory         string   `json:"directory"`
+	PullRequestNumber int      `json:"pull_request_number"`
+	Title             string   `json:"title"`
+	Body              string   `json:"body"`
+	State             string   `json:"state"`
+	BaseBranch        string   `json:"base_branch"`
+	Milestone         *string  `json:"milestone,omitempty"` // Milestone title; empty clears the milestone, nil leaves it unchanged
+	Assignees         []string `json:"assignees,omitempty"` // Replaces the assignees; empty clears them, nil leaves them unchanged
 }
 
 // PullRequestEditor defines the interface for editing pull requests in Git repositories
@@ -210,13 +382,14 @@ type PullRequestEditor interface {
 
 // CreatePullRequestArgs represents arguments for creating a new pull request
 type CreatePullRequestArgs struct {
-	Repository string `json:"repository"`
-	Head       string `json:"head"` // Source branch
-	Base       string `json:"base"` // Target branch
-	Title      string `json:"title"`
-	Body       string `json:"body"`
-	Draft      bool   `json:"draft"`
-	Assignee   string `json:"assignee"` // Single reviewer
+	Repository string   `json:"repository"`
+	Head       string   `json:"head"` // Source branch
+	Base       string   `json:"base"` // Target branch
+	Title      string   `json:"title"`
+	Body       string   `json:"body"`
+	Draft      bool     `json:"draft"`
+	Assignee   string   `json:"assignee"`            // Single assignee, merged into Assignees
+	Assignees  []string `json:"assignees,omitempty"` // Usernames to assign
 }
 
 // PullRequestCreator defines the interface for creating pull requests
@@ -224,11 +397,286 @@ type PullRequestCreator interface {
 	CreatePullRequest(ctx context.Context, args CreatePullRequestArgs) (*PullRequest, error)
 }
 
+// ReviewRequestArgs represents the arguments for requesting or removing pull request reviewers
+type ReviewRequestArgs struct {
+	Repository        string   `json:"repository"`
+	PullRequestNumber int      `json:"pull_request_number"`
+	Reviewers         []string `json:"reviewers,omitempty"`      // Usernames
+	TeamReviewers     []string `json:"team_reviewers,omitempty"` // Organization team names
+}
+
+// ReviewRequester defines the interface for managing requested reviewers on pull requests.
+// User reviewers must exist and have access to the repository.
+type ReviewRequester interface {
+	RequestReviewers(ctx context.Context, args ReviewRequestArgs) error
+	RemoveReviewRequests(ctx context.Context, args ReviewRequestArgs) error
+}
+
 // PullRequestGetter defines the interface for fetching a single pull request
 type PullRequestGetter interface {
 	GetPullRequest(ctx context.Context, repo string, number int) (*PullRequestDetails, error)
 }
 
+// Merge styles accepted by PullRequestMerger
+const (
+	MergeStyleMerge           = "merge"             // Create a merge commit
+	MergeStyleRebase          = "rebase"            // Rebase the head commits onto the base branch
+	MergeStyleRebaseMerge     = "rebase-merge"      // Rebase, then create a merge commit
+	MergeStyleSquash          = "squash"            // Squash all commits into one
+	MergeStyleFastForwardOnly = "fast-forward-only" // Fast-forward the base branch, failing if that is not possible
+)
+
+// MergePullRequestArgs represents the arguments for merging a pull request
+type MergePullRequestArgs struct {
+	Repository             string `json:"repository"`
+	PullRequestNumber      int    `json:"pull_request_number"`
+	Style                  string `json:"style"`   // One of the MergeStyle constants, defaults to MergeStyleMerge
+	Title                  string `json:"title"`   // Merge commit title, server default when empty
+	Message                string `json:"message"` // Merge commit message, server default when empty
+	DeleteBranchAfterMerge bool   `json:"delete_branch_after_merge"`
+	MergeWhenChecksSucceed bool   `json:"merge_when_checks_succeed"`
+}
+
+// MergeResult represents the outcome of a pull request merge request
+type MergeResult struct {
+	Merged    bool   `json:"merged"`    // The pull request was merged immediately
+	Scheduled bool   `json:"scheduled"` // The merge will happen once all status checks succeed
+	Style     string `json:"style"`
+}
+
+// PullRequestMerger defines the interface for merging pull requests
+type PullRequestMerger interface {
+	MergePullRequest(ctx context.Context, args MergePullRequestArgs) (*MergeResult, error)
+}
+
+// Review states used by PullRequestReviewer
+const (
+	ReviewStateApproved       = "APPROVED"
+	ReviewStateRequestChanges = "REQUEST_CHANGES"
+	ReviewStateComment        = "COMMENT"
+	ReviewStatePending        = "PENDING"
+)
+
+// PullRequestReview represents a review submitted on a pull request
+type PullRequestReview struct {
+	ID            int    `json:"id"`
+	Reviewer      string `json:"reviewer"`
+	State         string `json:"state"`
+	Body          string `json:"body,omitempty"`
+	CommitID      string `json:"commit_id,omitempty"`
+	Stale         bool   `json:"stale"`     // The pull request changed since the review
+	Official      bool   `json:"official"`  // The review counts towards required approvals
+	Dismissed     bool   `json:"dismissed"` // The review was dismissed by a maintainer
+	CommentsCount int    `json:"comments_count"`
+	Submitted     string `json:"submitted,omitempty"`
+	HTMLURL       string `json:"html_url,omitempty"`
+}
+
+// PullRequestReviewList represents a collection of pull request reviews with pagination metadata
+type PullRequestReviewList struct {
+	Reviews []PullRequestReview `json:"reviews"`
+	Total   int                 `json:"total"`
+	Limit   int                 `json:"limit"`
+	Offset  int                 `json:"offset"`
+}
+
+// PullRequestReviewComment represents an inline comment attached to a pull request review
+type PullRequestReviewComment struct {
+	ID       int    `json:"id"`
+	ReviewID int    `json:"review_id"`
+	Body     string `json:"body"`
+	Author   string `json:"user"`
+	Path     string `json:"path"`
+	Line     int    `json:"line,omitempty"`     // Line in the new version of the file
+	OldLine  int    `json:"old_line,omitempty"` // Line in the old version of the file
+	CommitID string `json:"commit_id,omitempty"`
+	DiffHunk string `json:"diff_hunk,omitempty"`
+	Resolved bool   `json:"resolved"`
+	Created  string `json:"created"`
+	Updated  string `json:"updated"`
+	HTMLURL  string `json:"html_url,omitempty"`
+}
+
+// ReviewCommentArgs represents an inline comment to attach to a new review.
+// Exactly one of NewLine or OldLine anchors the comment in the diff.
+type ReviewCommentArgs struct {
+	Path    string `json:"path"`
+	Body    string `json:"body"`
+	NewLine int    `json:"new_line"` // Line in the new version of the file, 0 if anchored to OldLine
+	OldLine int    `json:"old_line"` // Line in the old version of the file, 0 if anchored to NewLine
+}
+
+// CreatePullRequestReviewArgs represents the arguments for submitting a pull request review
+type CreatePullRequestReviewArgs struct {
+	Repository        string              `json:"repository"`
+	PullRequestNumber int                 `json:"pull_request_number"`
+	State             string              `json:"state"` // One of the ReviewState constants
+	Body              string              `json:"body"`
+	CommitID          string              `json:"commit_id"` // Commit being reviewed, PR head when empty
+	Comments          []ReviewCommentArgs `json:"comments"`
+}
+
+// PullRequestReviewer defines the interface for submitting and reading pull request reviews
+type PullRequestReviewer interface {
+	CreatePullRequestReview(ctx context.Context, args CreatePullRequestReviewArgs) (*PullRequestReview, error)
+	ListPullRequestReviews(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*PullRequestReviewList, error)
+	ListPullRequestReviewComments(ctx context.Context, repo string, pullRequestNumber, reviewID int) ([]PullRequestReviewComment, error)
+}
+
+// ChangedFile represents a file changed by a pull request
+type ChangedFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"` // Set when the file was renamed
+	Status           string `json:"status"`                      // "added", "modified", "deleted", "renamed", ...
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+}
+
+// PullRequestFileList represents a collection of changed files with pagination metadata
+type PullRequestFileList struct {
+	Files  []ChangedFile `json:"files"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// PullRequestDiffGetter defines the interface for reading the changes of a pull request
+type PullRequestDiffGetter interface {
+	GetPullRequestDiff(ctx context.Context, repo string, pullRequestNumber int) (string, error)
+	ListPullRequestFiles(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*PullRequestFileList, error)
+}
+
+// Commit status states reported by CI checks
+const (
+	CommitStatusPending = "pending"
+	CommitStatusSuccess = "success"
+	CommitStatusError   = "error"
+	CommitStatusFailure = "failure"
+	CommitStatusWarning = "warning"
+)
+
+// CommitStatus represents a single CI check context reported for a commit
+type CommitStatus struct {
+	ID          int    `json:"id"`
+	Context     string `json:"context"`
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Creator     string `json:"creator,omitempty"`
+	Created     string `json:"created"`
+	Updated     string `json:"updated"`
+}
+
+// CombinedCommitStatus represents the overall CI state of a commit and its individual checks
+type CombinedCommitStatus struct {
+	State      string         `json:"state"`
+	SHA        string         `json:"sha"`
+	TotalCount int            `json:"total_count"`
+	Statuses   []CommitStatus `json:"statuses"`
+}
+
+// CommitStatusGetter defines the interface for reading CI statuses of a commit
+type CommitStatusGetter interface {
+	GetCombinedCommitStatus(ctx context.Context, repo, ref string) (*CombinedCommitStatus, error)
+}
+
+// ActionRun represents a single workflow run of Forgejo Actions
+type ActionRun struct {
+	ID          int    `json:"id"`
+	RunNumber   int    `json:"run_number"` // Sequential run number within the repository
+	Title       string `json:"title"`
+	WorkflowID  string `json:"workflow_id"` // Workflow file name, e.g. "ci.yml"
+	Status      string `json:"status"`      // e.g. "success", "failure", "running", "waiting"
+	Event       string `json:"event"`
+	Branch      string `json:"branch"`
+	CommitSHA   string `json:"commit_sha"`
+	TriggerUser string `json:"trigger_user,omitempty"`
+	Created     string `json:"created"`
+	Started     string `json:"started,omitempty"`
+	Stopped     string `json:"stopped,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+}
+
+// ActionRunList represents a collection of workflow runs with pagination metadata
+type ActionRunList struct {
+	Runs   []ActionRun `json:"runs"`
+	Total  *int        `json:"total,omitempty"` // nil when the server cannot count the filtered runs
+	Limit  int         `json:"limit"`
+	Offset int         `json:"offset"`
+}
+
+// ListActionRunsArgs represents the filters for listing workflow runs
+type ListActionRunsArgs struct {
+	Repository string
+	Branch     string // Only runs triggered on this branch
+	HeadSHA    string // Only runs for this commit
+	Status     string // Only runs in this status
+	Event      string // Only runs triggered by this event
+	Limit      int
+	Offset     int
+}
+
+// ActionJob represents a single job of a workflow run
+type ActionJob struct {
+	ID         int    `json:"id"`
+	RunID      int    `json:"run_id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+	Started    string `json:"started,omitempty"`
+	Completed  string `json:"completed,omitempty"`
+	HTMLURL    string `json:"html_url,omitempty"`
+}
+
+// ActionsReader defines the interface for inspecting CI workflow runs, their jobs, and job logs.
+// Clients for servers without an Actions API return errors wrapping ErrUnsupported.
+type ActionsReader interface {
+	ListActionRuns(ctx context.Context, args ListActionRunsArgs) (*ActionRunList, error)
+	ListActionJobs(ctx context.Context, repo string, runID int) ([]ActionJob, error)
+	GetActionJobLog(ctx context.Context, repo string, jobID int) (string, error)
+}
+
+// IssueDetails represents comprehensive issue information
+type IssueDetails struct {
+	// Basic fields (matching Issue for compatibility)
+	ID        int    `json:"id"`
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	State     string `json:"state"`
+	User      string `json:"user"`
+	CreatedAt string `json:"created"`
+	UpdatedAt string `json:"updated"`
+
+	// Additional metadata fields
+	HTMLURL     string             `json:"html_url"`
+	Labels      []Label            `json:"labels,omitempty"`
+	Milestone   *Milestone         `json:"milestone,omitempty"`
+	Assignees   []string           `json:"assignees,omitempty"`
+	Comments    int                `json:"comments"`
+	IsLocked    bool               `json:"is_locked"`
+	ClosedAt    string             `json:"closed_at,omitempty"`
+	DueDate     string             `json:"due_date,omitempty"`
+	Reactions   []ReactionSummary  `json:"reactions,omitempty"`
+	PullRequest *LinkedPullRequest `json:"pull_request,omitempty"` // Set when the issue is a pull request
+}
+
+// ReactionSummary represents the users that reacted with one emoji
+type ReactionSummary struct {
+	Content string   `json:"content"` // Reaction name, e.g. "+1" or "heart"
+	Count   int      `json:"count"`
+	Users   []string `json:"users"`
+}
+
+// LinkedPullRequest represents the pull request backing an issue
+type LinkedPullRequest struct {
+	Number   int    `json:"number"`
+	HTMLURL  string `json:"html_url"`
+	Merged   bool   `json:"merged"`
+	MergedAt string `json:"merged_at,omitempty"`
+}
+
 // PullRequestDetails represents comprehensive pull request information
 type PullRequestDetails struct {
 	// Basic fields (matching PullRequest for compatibility)
@@ -271,6 +719,42 @@ type Label struct {
 	Description string `json:"description,omitempty"`
 }
 
+// LabelList represents a collection of repository labels with pagination metadata
+type LabelList struct {
+	Labels []Label `json:"labels"`
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+}
+
+// CreateLabelArgs represents the arguments for creating a repository label
+type CreateLabelArgs struct {
+	Repository  string `json:"repository"`
+	Name        string `json:"name"`
+	Color       string `json:"color"` // Hex color, e.g. "#ee0701"
+	Description string `json:"description,omitempty"`
+}
+
+// EditLabelArgs represents the arguments for editing a repository label.
+// Nil fields are left unchanged.
+type EditLabelArgs struct {
+	Repository  string  `json:"repository"`
+	Name        string  `json:"name"` // Current label name
+	NewName     *string `json:"new_name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// LabelManager defines the interface for managing repository labels and their assignment to issues and pull requests.
+// Labels are identified by name; implementations resolve names to IDs.
+type LabelManager interface {
+	ListLabels(ctx context.Context, repo string, limit, offset int) (*LabelList, error)
+	CreateLabel(ctx context.Context, args CreateLabelArgs) (*Label, error)
+	EditLabel(ctx context.Context, args EditLabelArgs) (*Label, error)
+	AddIssueLabels(ctx context.Context, repo string, issueNumber int, labels []string) ([]Label, error)
+	RemoveIssueLabels(ctx context.Context, repo string, issueNumber int, labels []string) ([]Label, error)
+}
+
 // Milestone represents a repository milestone
 type Milestone struct {
 	ID           int    `json:"id"`
@@ -279,6 +763,58 @@ type Milestone struct {
 	State        string `json:"state"`
 	OpenIssues   int    `json:"open_issues"`
 	ClosedIssues int    `json:"closed_issues"`
+	DueDate      string `json:"due_date,omitempty"`
+}
+
+// MilestoneList represents a collection of repository milestones with pagination metadata
+type MilestoneList struct {
+	Milestones []Milestone `json:"milestones"`
+	Total      int         `json:"total"`
+	Limit      int         `json:"limit"`
+	Offset     int         `json:"offset"`
+}
+
+// CreateMilestoneArgs represents the arguments for creating a repository milestone
+type CreateMilestoneArgs struct {
+	Repository  string     `json:"repository"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+}
+
+// EditMilestoneArgs represents the arguments for editing a repository milestone.
+// Nil fields are left unchanged.
+type EditMilestoneArgs struct {
+	Repository  string     `json:"repository"`
+	Title       string     `json:"title"` // Current milestone title
+	NewTitle    *string    `json:"new_title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	State       *string    `json:"state,omitempty"` // "open" or "closed"
+}
+
+// MilestoneManager defines the interface for managing repository milestones.
+// Milestones are identified by title; implementations resolve titles to IDs.
+type MilestoneManager interface {
+	ListMilestones(ctx context.Context, repo, state string, limit, offset int) (*MilestoneList, error)
+	CreateMilestone(ctx context.Context, args CreateMilestoneArgs) (*Milestone, error)
+	EditMilestone(ctx context.Context, args EditMilestoneArgs) (*Milestone, error)
+}
+
+// Reaction represents a single emoji reaction left by a user
+type Reaction struct {
+	Content string `json:"content"` // Reaction name, e.g. "+1" or "eyes"
+	User    string `json:"user"`
+	Created string `json:"created,omitempty"`
+}
+
+// ReactionManager defines the interface for listing, adding and removing emoji reactions on
+// issue and pull request bodies and on their comments. Reactions are added and removed as the
+// authenticated user.
+type ReactionManager interface {
+	ListReactions(ctx context.Context, target IssueOrCommentTarget) ([]Reaction, error)
+	AddReaction(ctx context.Context, target IssueOrCommentTarget, content string) (*Reaction, error)
+	RemoveReaction(ctx context.Context, target IssueOrCommentTarget, content string) error
 }
 
 // Notification represents a user notification from a Git repository
@@ -289,6 +825,7 @@ type Notification struct {
 	Number     int    `json:"number"` // Issue/PR number (0 if not applicable)
 	Title      string `json:"title"`
 	Unread     bool   `json:"unread"`
+	Pinned     bool   `json:"pinned,omitempty"`
 	Updated    string `json:"updated"`
 }
 
@@ -305,19 +842,323 @@ type NotificationLister interface {
 	ListNotifications(ctx context.Context, repo string, status string, limit, offset int) (*NotificationList, error)
 }
 
-// FileContentFetcher defines interface for fetching repository file contents
+// Notification statuses accepted by NotificationManager.MarkNotification
+const (
+	NotificationStatusRead   = "read"
+	NotificationStatusUnread = "unread"
+	NotificationStatusPinned = "pinned"
+)
+
+// NotificationManager extends NotificationLister with marking notification threads and with
+// subscribing the authenticated user to issue and pull request threads. Marking a pinned
+// notification as read or unread unpins it.
+type NotificationManager interface {
+	NotificationLister
+	MarkNotification(ctx context.Context, id int, status string) (*Notification, error)
+	MarkRepositoryNotificationsRead(ctx context.Context, repo string) ([]Notification, error)
+	SubscribeIssue(ctx context.Context, repo string, number int) error
+	UnsubscribeIssue(ctx context.Context, repo string, number int) error
+}
+
+// Reasons a notification needs the authenticated user's attention, as reported by NotificationDigester
+const (
+	NotificationReasonReviewRequested = "review_requested" // The user is a requested reviewer of the pull request
+	NotificationReasonMention         = "mention"          // The user is mentioned in the subject or its latest comment
+	NotificationReasonAssigned        = "assigned"         // The issue or pull request is assigned to the user
+	NotificationReasonCI              = "ci"               // The pull request head commit has failing status checks
+	NotificationReasonSubscribed      = "subscribed"       // None of the above; the user watches the thread
+)
+
+// NotificationDetails represents an unread notification enriched with the state of its subject
+type NotificationDetails struct {
+	Notification
+	State               string   `json:"state,omitempty"` // Subject state: "open", "closed" or "merged"
+	HTMLURL             string   `json:"html_url,omitempty"`
+	Reasons             []string `json:"reasons"` // NotificationReason constants; never empty
+	LatestCommentID     int      `json:"latest_comment_id,omitempty"`
+	LatestCommentAuthor string   `json:"latest_comment_author,omitempty"`
+	LatestComment       string   `json:"latest_comment,omitempty"`
+	CIState             string   `json:"ci_state,omitempty"` // Combined status of the pull request head commit
+}
+
+// NotificationDigester defines the interface for building a digest of unread notifications.
+// ListUnreadNotificationDetails returns every unread notification with the reasons that can be
+// found without reading each subject, so that they can be ranked cheaply, and
+// EnrichNotificationDetails then reads the subjects of the notifications that are kept, adding
+// the latest comment, the CI state and the reasons these reveal. Subject lookups are best-effort:
+// a notification whose issue, pull request or comment cannot be fetched is left as it is.
+type NotificationDigester interface {
+	ListUnreadNotificationDetails(ctx context.Context, repo string) ([]NotificationDetails, error)
+	EnrichNotificationDetails(ctx context.Context, details []NotificationDetails) error
+}
+
+// RepositoryFile represents a file read from a repository at a ref
+type RepositoryFile struct {
+	Path    string `json:"path"`
+	Name    string `json:"name"`
+	SHA     string `json:"sha"` // Blob SHA of the file content
+	Size    int64  `json:"size"`
+	Ref     string `json:"ref,omitempty"` // Branch, tag or commit the file was read at; empty for the default branch
+	Content []byte `json:"-"`
+}
+
+// TreeEntry represents a file, directory or submodule in a repository tree
+type TreeEntry struct {
+	Path string `json:"path"` // Path relative to the repository root
+	Type string `json:"type"` // "blob", "tree" or "commit"
+	Mode string `json:"mode"`
+	Size int64  `json:"size,omitempty"`
+	SHA  string `json:"sha"`
+}
+
+// RepositoryTree represents the entries below a directory of a repository at a ref
+type RepositoryTree struct {
+	Ref       string      `json:"ref"`
+	SHA       string      `json:"sha"` // Tree SHA of the directory
+	Entries   []TreeEntry `json:"entries"`
+	Truncated bool        `json:"truncated"` // Whether entries were left out because of the limit
+}
+
+// FileContentFetcher defines interface for fetching repository file contents and browsing
+// repository trees. An empty ref selects the default branch of the repository.
+// GetRepositoryFile fails without reading the content once the file exceeds maxSize bytes;
+// zero or less disables the limit.
 type FileContentFetcher interface {
 	GetFileContent(ctx context.Context, owner, repo, ref, filepath string) ([]byte, error)
+	GetRepositoryFile(ctx context.Context, repo, ref, filepath string, maxSize int64) (*RepositoryFile, error)
+	ListRepositoryTree(ctx context.Context, repo, ref, dirpath string, recursive bool, limit int) (*RepositoryTree, error)
+}
+
+// CommitIdentity represents the name and email of a commit author or committer
+type CommitIdentity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// FileChangeArgs represents the arguments for committing a change to a repository file
+type FileChangeArgs struct {
+	Repository string         `json:"repository"`
+	Path       string         `json:"path"`
+	Message    string         `json:"message"`
+	Branch     string         `json:"branch"`     // Branch to commit to; empty for the default branch
+	NewBranch  string         `json:"new_branch"` // Branch created from Branch to hold the commit (optional)
+	SHA        string         `json:"sha"`        // Blob SHA of the file as last read; empty when creating a file
+	Author     CommitIdentity `json:"author"`     // Defaults to the committer, then to the authenticated user
+	Committer  CommitIdentity `json:"committer"`  // Defaults to the author, then to the authenticated user
+}
+
+// WriteFileArgs represents the arguments for creating or updating a repository file
+type WriteFileArgs struct {
+	FileChangeArgs
+	Content []byte `json:"-"`
+}
+
+// FileCommit represents the commit that created, updated or deleted a repository file
+type FileCommit struct {
+	Path      string `json:"path"`
+	SHA       string `json:"sha,omitempty"`    // Blob SHA of the new content; empty after a delete
+	Branch    string `json:"branch,omitempty"` // Branch holding the commit; empty for the default branch
+	CommitSHA string `json:"commit_sha,omitempty"`
+	CommitURL string `json:"commit_url,omitempty"`
+}
+
+// FileContentWriter defines the interface for committing file changes to a branch without a local
+// clone. Files are created when no SHA is given and must then not exist yet; updates and deletes
+// must give the SHA the file currently has. Otherwise the change fails with an error wrapping
+// ErrFileConflict instead of overwriting a concurrent edit.
+type FileContentWriter interface {
+	WriteFile(ctx context.Context, args WriteFileArgs) (*FileCommit, error)
+	DeleteFile(ctx context.Context, args FileChangeArgs) (*FileCommit, error)
+}
+
+// Commit represents a repository commit. Parents, Stats and Files are only set for single commits.
+type Commit struct {
+	SHA         string       `json:"sha"`
+	Message     string       `json:"message"`
+	Author      string       `json:"author"`
+	AuthorEmail string       `json:"author_email,omitempty"`
+	AuthorLogin string       `json:"author_login,omitempty"` // Username of the account linked to the author email
+	Date        string       `json:"date,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Parents     []string     `json:"parents,omitempty"`
+	Stats       *CommitStats `json:"stats,omitempty"`
+	Files       []CommitFile `json:"files,omitempty"`
+}
+
+// CommitStats represents the number of lines changed by a commit
+type CommitStats struct {
+	Total     int `json:"total"`
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+}
+
+// CommitFile represents a file changed by a commit
+type CommitFile struct {
+	Filename string `json:"filename"`
+	Status   string `json:"status,omitempty"` // "added", "modified", "removed", ...
+}
+
+// Branch represents a repository branch with its last commit and protection status
+type Branch struct {
+	Name              string `json:"name"`
+	Commit            Commit `json:"commit"`
+	Protected         bool   `json:"protected"`
+	ProtectionRule    string `json:"protection_rule,omitempty"` // Name of the branch protection rule that applies
+	RequiredApprovals int    `json:"required_approvals,omitempty"`
+	UserCanPush       bool   `json:"user_can_push"`
+	UserCanMerge      bool   `json:"user_can_merge"`
+}
+
+// BranchList represents a collection of repository branches with pagination metadata
+type BranchList struct {
+	Branches []Branch `json:"branches"`
+	Total    int      `json:"total"`
+	Limit    int      `json:"limit"`
+	Offset   int      `json:"offset"`
+}
+
+// BranchComparison represents the commits that differ between two branches. AheadBy and
+// BehindBy count all commits, while the commit lists hold at most the requested number.
+type BranchComparison struct {
+	Base          string   `json:"base"`
+	Head          string   `json:"head"`
+	AheadBy       int      `json:"ahead_by"`       // Commits on head that are not on base
+	BehindBy      int      `json:"behind_by"`      // Commits on base that are not on head
+	AheadCommits  []Commit `json:"ahead_commits"`  // Commits on head that are not on base
+	BehindCommits []Commit `json:"behind_commits"` // Commits on base that are not on head
+}
+
+// BranchManager defines the interface for managing the branches of a remote repository
+type BranchManager interface {
+	ListBranches(ctx context.Context, repo string, limit, offset int) (*BranchList, error)
+	CreateBranch(ctx context.Context, repo, name, from string) (*Branch, error)
+	DeleteBranch(ctx context.Context, repo, name string) error
+	CompareBranches(ctx context.Context, repo, base, head string, limit int) (*BranchComparison, error)
+}
+
+// ListCommitsOptions represents the filters for listing repository commits
+type ListCommitsOptions struct {
+	Ref    string    `json:"ref"`    // Branch, tag or commit SHA to list from; empty uses the default branch
+	Path   string    `json:"path"`   // Only commits changing this file or directory
+	Author string    `json:"author"` // Name, email or username of the author, matched case-insensitively
+	Since  time.Time `json:"since"`  // Only commits at or after this time
+	Until  time.Time `json:"until"`  // Only commits before this time
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+}
+
+// CommitList represents a collection of commits with pagination metadata
+type CommitList struct {
+	Commits     []Commit `json:"commits"`
+	Total       *int     `json:"total,omitempty"` // nil when an author search cannot count the matching commits
+	Limit       int      `json:"limit"`
+	Offset      int      `json:"offset"`
+	ScanLimited bool     `json:"scan_limited,omitempty"` // An author search stopped before the end of the history
+}
+
+// CommitLister defines the interface for reading the commit history of a repository and the
+// commits of pull requests. Repository commits are listed newest first.
+type CommitLister interface {
+	ListCommits(ctx context.Context, repo string, options ListCommitsOptions) (*CommitList, error)
+	GetCommit(ctx context.Context, repo, sha string) (*Commit, error)
+	GetCommitDiff(ctx context.Context, repo, sha string) (string, error)
+	ListPullRequestCommits(ctx context.Context, repo string, pullRequestNumber int, limit, offset int) (*CommitList, error)
+}
+
+// Release represents a repository release with its uploaded assets
+type Release struct {
+	ID         int          `json:"id"`
+	TagName    string       `json:"tag_name"`
+	Target     string       `json:"target_commitish,omitempty"` // Branch or commit SHA the tag is created from
+	Name       string       `json:"name"`
+	Body       string       `json:"body,omitempty"` // Release notes
+	Draft      bool         `json:"draft"`
+	Prerelease bool         `json:"prerelease"`
+	Author     string       `json:"author,omitempty"`
+	URL        string       `json:"url,omitempty"`
+	Created    string       `json:"created,omitempty"`
+	Published  string       `json:"published,omitempty"`
+	Assets     []Attachment `json:"assets,omitempty"`
+}
+
+// ReleaseList represents a collection of repository releases with pagination metadata
+type ReleaseList struct {
+	Releases []Release `json:"releases"`
+	Total    int       `json:"total"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
+
+// CreateReleaseArgs represents the arguments for creating a release. The tag is created from
+// Target, or the default branch, when it does not exist yet.
+type CreateReleaseArgs struct {
+	Repository string `json:"repository"`
+	TagName    string `json:"tag_name"`
+	Target     string `json:"target_commitish,omitempty"`
+	Name       string `json:"name"`
+	Body       string `json:"body,omitempty"`
+	Draft      bool   `json:"draft,omitempty"`
+	Prerelease bool   `json:"prerelease,omitempty"`
+}
+
+// EditReleaseArgs represents the arguments for editing a release identified by its tag.
+// Nil fields are left unchanged.
+type EditReleaseArgs struct {
+	Repository string  `json:"repository"`
+	TagName    string  `json:"tag_name"` // Current tag of the release
+	NewTagName *string `json:"new_tag_name,omitempty"`
+	Target     *string `json:"target_commitish,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Body       *string `json:"body,omitempty"`
+	Draft      *bool   `json:"draft,omitempty"`
+	Prerelease *bool   `json:"prerelease,omitempty"`
 }
 
-// ClientInterface combines IssueLister, IssueCommenter, IssueCommentLister, IssueCommentEditor, IssueCreator, IssueAttachmentCreator, IssueEditor, PullRequestLister, PullRequestCommentLister, PullRequestCommenter, PullRequestCommentEditor, PullRequestEditor, PullRequestCreator, PullRequestGetter, NotificationLister, and FileContentFetcher for complete Git operations
+// ReleaseManager defines the interface for listing, creating and editing releases and
+// uploading their assets. Releases are identified by tag; implementations resolve tags to IDs.
+type ReleaseManager interface {
+	ListReleases(ctx context.Context, repo string, limit, offset int) (*ReleaseList, error)
+	GetRelease(ctx context.Context, repo, tag string) (*Release, error)
+	CreateRelease(ctx context.Context, args CreateReleaseArgs) (*Release, error)
+	EditRelease(ctx context.Context, args EditReleaseArgs) (*Release, error)
+	UploadReleaseAsset(ctx context.Context, repo string, releaseID int, file ProcessedAttachment) (*Attachment, error)
+}
+
+// Tag represents a git tag of a repository
+type Tag struct {
+	Name      string `json:"name"`
+	CommitSHA string `json:"commit_sha"`
+	Message   string `json:"message,omitempty"` // Set for annotated tags
+}
+
+// TagList represents a collection of repository tags with pagination metadata
+type TagList struct {
+	Tags   []Tag `json:"tags"`
+	Total  int   `json:"total"`
+	Limit  int   `json:"limit"`
+	Offset int   `json:"offset"`
+}
+
+// TagManager defines the interface for listing and creating git tags. CreateTag creates an
+// annotated tag when message is set and tags the default branch when target is empty.
+type TagManager interface {
+	ListTags(ctx context.Context, repo string, limit, offset int) (*TagList, error)
+	CreateTag(ctx context.Context, repo, name, target, message string) (*Tag, error)
+}
+
+// ClientInterface combines IssueLister, IssueSearcher, IssueGetter, IssueCommenter, IssueCommentLister, IssueTimelineReader, IssueCommentEditor, CommentDeleter, IssueCreator, AttachmentUploader, AttachmentReader, IssueEditor, PullRequestLister, PullRequestCommentLister, PullRequestCommenter, PullRequestCommentEditor, PullRequestEditor, PullRequestCreator, PullRequestGetter, PullRequestMerger, PullRequestReviewer, ReviewRequester, PullRequestDiffGetter, CommitStatusGetter, ActionsReader, LabelManager, MilestoneManager, ReactionManager, NotificationManager, NotificationDigester, FileContentFetcher, FileContentWriter, BranchManager, CommitLister, ReleaseManager, and TagManager for complete Git operations
 type ClientInterface interface {
 	IssueLister
+	IssueSearcher
+	IssueGetter
 	IssueCommenter
 	IssueCommentLister
+	IssueTimelineReader
 	IssueCommentEditor
+	CommentDeleter
 	IssueCreator
-	IssueAttachmentCreator
+	AttachmentUploader
+	AttachmentReader
 	IssueEditor
 	PullRequestLister
 	PullRequestCommentLister
@@ -326,6 +1167,21 @@ type ClientInterface interface {
 	PullRequestEditor
 	PullRequestCreator
 	PullRequestGetter
-	NotificationLister
+	PullRequestMerger
+	PullRequestReviewer
+	ReviewRequester
+	PullRequestDiffGetter
+	CommitStatusGetter
+	ActionsReader
+	LabelManager
+	MilestoneManager
+	ReactionManager
+	NotificationManager
+	NotificationDigester
 	FileContentFetcher
+	FileContentWriter
+	BranchManager
+	CommitLister
+	ReleaseManager
+	TagManager
 }
@@ -0,0 +1,390 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultJobLogTail is the number of trailing log lines returned unless overridden
+const defaultJobLogTail = 200
+
+// ActionRunListArgs represents the arguments for listing Actions workflow runs
+type ActionRunListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Branch     string `json:"branch,omitzero"`     // Only runs triggered on this branch
+	HeadSHA    string `json:"head_sha,omitzero"`   // Only runs for this commit
+	Status     string `json:"status,omitzero"`     // Only runs in this status
+	Event      string `json:"event,omitzero"`      // Only runs triggered by this event, e.g. "push"
+	Limit      int    `json:"limit,omitzero"`
+	Offset     int    `json:"offset,omitzero"`
+}
+
+// ActionRunList represents the result data for the action_run_list tool
+type ActionRunList struct {
+	Runs   []remote.ActionRun `json:"runs"`
+	Total  *int               `json:"total,omitempty"` // Omitted when a branch filter stopped before the last run
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+}
+
+// ActionJobListArgs represents the arguments for listing the jobs of a workflow run
+type ActionJobListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	RunID      int    `json:"run_id"`              // Run ID from action_run_list
+}
+
+// ActionJobList represents the result data for the action_job_list tool
+type ActionJobList struct {
+	Jobs []remote.ActionJob `json:"jobs"`
+}
+
+// ActionJobLogArgs represents the arguments for fetching a job log
+type ActionJobLogArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	JobID      int    `json:"job_id"`              // Job ID from action_job_list
+	Grep       string `json:"grep,omitzero"`       // Only keep lines matching this regular expression
+	Tail       int    `json:"tail,omitzero"`       // Return at most this many trailing lines
+}
+
+// ActionJobLogResult represents the result data for the action_job_log tool
+type ActionJobLogResult struct {
+	Log           string `json:"log"`
+	TotalLines    int    `json:"total_lines"`    // Lines in the full log
+	MatchedLines  int    `json:"matched_lines"`  // Lines matching grep, or all lines without grep
+	ReturnedLines int    `json:"returned_lines"` // Lines included in log after tail
+	Truncated     bool   `json:"truncated"`      // Whether matching lines were dropped by tail
+}
+
+// filterJobLog keeps the lines of log matching pattern (all lines when nil) and returns the last tail of them
+func filterJobLog(log string, pattern *regexp.Regexp, tail int) *ActionJobLogResult {
+	lines := strings.Split(strings.TrimSuffix(log, "\n"), "\n")
+	if log == "" {
+		lines = nil
+	}
+
+	matched := lines
+	if pattern != nil {
+		matched = nil
+		for _, line := range lines {
+			if pattern.MatchString(line) {
+				matched = append(matched, line)
+			}
+		}
+	}
+
+	result := &ActionJobLogResult{
+		TotalLines:   len(lines),
+		MatchedLines: len(matched),
+	}
+	if len(matched) > tail {
+		result.Truncated = true
+		matched = matched[len(matched)-tail:]
+	}
+	result.ReturnedLines = len(matched)
+
+	var builder strings.Builder
+	if result.Truncated {
+		fmt.Fprintf(&builder, "... [%d earlier lines omitted] ...\n", result.MatchedLines-result.ReturnedLines)
+	}
+	for _, line := range matched {
+		builder.WriteString(line)
+		builder.WriteString("\n")
+	}
+	result.Log = builder.String()
+	return result
+}
+
+// handleActionRunList handles the "action_run_list" tool request.
+// It lists Forgejo Actions workflow runs of a repository, newest first.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - branch: Only runs triggered on this branch (optional)
+//   - head_sha: Only runs for this commit (optional)
+//   - status: Only runs in this status (optional)
+//   - event: Only runs triggered by this event (optional)
+//   - limit: Maximum number of runs to return (1-100, default 15)
+//   - offset: Number of runs to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Workflow runs with status, trigger, branch, and commit
+//   - Error: Validation errors, API failures, or an unsupported remote
+func (s *Server) handleActionRunList(ctx context.Context, request *mcp.CallToolRequest, args ActionRunListArgs) (*mcp.CallToolResult, *ActionRunList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Branch, v.Match(emptyReg).Error("branch cannot be only whitespace")),
+		v.Field(&args.HeadSHA, v.Match(emptyReg).Error("head_sha cannot be only whitespace")),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// List workflow runs
+	runList, err := client.ListActionRuns(ctx, remote.ListActionRunsArgs{
+		Repository: repository,
+		Branch:     args.Branch,
+		HeadSHA:    args.HeadSHA,
+		Status:     args.Status,
+		Event:      args.Event,
+		Limit:      args.Limit,
+		Offset:     args.Offset,
+	})
+	if err != nil {
+		return TextErrorf("Failed to list action runs: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatActionRunList(runList.Runs)
+	} else {
+		responseText = fmt.Sprintf("Found %d action runs", len(runList.Runs))
+	}
+
+	return TextResult(responseText), &ActionRunList{
+		Runs:   runList.Runs,
+		Total:  runList.Total,
+		Limit:  runList.Limit,
+		Offset: runList.Offset,
+	}, nil
+}
+
+// handleActionJobList handles the "action_job_list" tool request.
+// It lists the jobs of a single Forgejo Actions workflow run.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - run_id: The run ID, as returned by action_run_list (must be positive)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Jobs with status, conclusion, and timing
+//   - Error: Validation errors, API failures, or an unsupported remote
+func (s *Server) handleActionJobList(ctx context.Context, request *mcp.CallToolRequest, args ActionJobListArgs) (*mcp.CallToolResult, *ActionJobList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.RunID, v.Required.Error("run ID is required"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// List the run's jobs
+	jobs, err := client.ListActionJobs(ctx, repository, args.RunID)
+	if err != nil {
+		return TextErrorf("Failed to list action jobs: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatActionJobList(jobs)
+	} else {
+		responseText = fmt.Sprintf("Found %d jobs in run %d", len(jobs), args.RunID)
+	}
+
+	return TextResult(responseText), &ActionJobList{Jobs: jobs}, nil
+}
+
+// handleActionJobLog handles the "action_job_log" tool request.
+// It fetches the log of a Forgejo Actions job, optionally keeping only lines that match
+// a regular expression, and returns the trailing lines so failures fit a model context.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - job_id: The job ID, as returned by action_job_list (must be positive)
+//   - grep: Regular expression selecting lines to keep (optional, use "(?i)" for case-insensitive)
+//   - tail: Maximum number of trailing lines to return (1-5000, default 200)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The selected log lines with counts of total, matched, and returned lines
+//   - Error: Validation errors, API failures, or an unsupported remote
+func (s *Server) handleActionJobLog(ctx context.Context, request *mcp.CallToolRequest, args ActionJobLogArgs) (*mcp.CallToolResult, *ActionJobLogResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default tail if not provided
+	if args.Tail == 0 {
+		args.Tail = defaultJobLogTail
+	}
+
+	// Validate input arguments using ozzo-validation
+	var pattern *regexp.Regexp
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.JobID, v.Required.Error("job ID is required"), v.Min(1)),
+		v.Field(&args.Grep, v.By(func(any) error {
+			if args.Grep == "" {
+				return nil
+			}
+			var err error
+			if pattern, err = regexp.Compile(args.Grep); err != nil {
+				return v.NewError("grep_pattern", "grep must be a valid regular expression")
+			}
+			return nil
+		})),
+		v.Field(&args.Tail, v.Min(1), v.Max(5000)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Fetch the full log
+	log, err := client.GetActionJobLog(ctx, repository, args.JobID)
+	if err != nil {
+		return TextErrorf("Failed to get action job log: %v", err), nil, nil
+	}
+	result := filterJobLog(log, pattern, args.Tail)
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatActionJobLog(args.JobID, result)
+	} else {
+		responseText = fmt.Sprintf("Returned %d of %d log lines for job %d", result.ReturnedLines, result.TotalLines, args.JobID)
+	}
+
+	return TextResult(responseText), result, nil
+}
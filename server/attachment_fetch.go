@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// AttachmentListArgs represents the arguments for listing attachments
+type AttachmentListArgs struct {
+	Repository  string `json:"repository,omitzero"`   // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`    // Local directory path for automatic resolution
+	IssueNumber int    `json:"issue_number,omitzero"` // Issue or pull request number, for files attached to its body
+	CommentID   int    `json:"comment_id,omitzero"`   // Comment ID, for files attached to a comment
+}
+
+// AttachmentGetArgs represents the arguments for getting an attachment
+type AttachmentGetArgs struct {
+	Repository   string `json:"repository,omitzero"`   // Repository path in "owner/repo" format
+	Directory    string `json:"directory,omitzero"`    // Local directory path for automatic resolution
+	IssueNumber  int    `json:"issue_number,omitzero"` // Issue or pull request number, for files attached to its body
+	CommentID    int    `json:"comment_id,omitzero"`   // Comment ID, for files attached to a comment
+	AttachmentID int    `json:"attachment_id"`         // Attachment ID, as returned by attachment_list
+}
+
+// AttachmentListResult represents the result data for the attachment_list tool
+type AttachmentListResult struct {
+	Attachments []remote.Attachment `json:"attachments"`
+}
+
+// AttachmentGetResult represents the result data for the attachment_get tool
+type AttachmentGetResult struct {
+	Attachment remote.Attachment `json:"attachment"`
+	MIMEType   string            `json:"mime_type"`
+}
+
+// handleAttachmentList handles the "attachment_list" tool request.
+// It lists the files attached to an issue or pull request body, or to a comment.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - comment_id: The comment ID
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of
+// issue_number or comment_id must be provided.
+//
+// Returns:
+//   - Success: Attachment names, sizes and download URLs
+//   - Error: Validation errors or API failures
+func (s *Server) handleAttachmentList(ctx context.Context, request *mcp.CallToolRequest, args AttachmentListArgs) (*mcp.CallToolResult, *AttachmentListResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args, issueOrCommentTargetRules(&args.Repository, &args.Directory, &args.IssueNumber, &args.CommentID)...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	target, errResult := s.resolveIssueOrCommentTarget(args.Repository, args.Directory, args.IssueNumber, args.CommentID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	attachments, err := client.ListAttachments(ctx, target)
+	if err != nil {
+		return TextErrorf("Failed to list attachments: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatAttachmentList(attachments)
+	} else {
+		responseText = fmt.Sprintf("Found %d attachments", len(attachments))
+	}
+
+	return TextResult(responseText), &AttachmentListResult{Attachments: attachments}, nil
+}
+
+// handleAttachmentGet handles the "attachment_get" tool request.
+// It downloads a file attached to an issue or pull request body, or to a comment, and returns
+// its content: images as image content, text such as logs as a text resource, and other files
+// as a blob resource.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - comment_id: The comment ID
+//   - attachment_id: The attachment ID
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of
+// issue_number or comment_id must be provided. Files larger than attachment.max_size
+// are refused.
+//
+// Returns:
+//   - Success: The attachment metadata followed by its content
+//   - Error: Validation errors, files over the size limit, or API failures
+func (s *Server) handleAttachmentGet(ctx context.Context, request *mcp.CallToolRequest, args AttachmentGetArgs) (*mcp.CallToolResult, *AttachmentGetResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	rules := issueOrCommentTargetRules(&args.Repository, &args.Directory, &args.IssueNumber, &args.CommentID)
+	rules = append(rules, v.Field(&args.AttachmentID, v.Required.Error("attachment_id is required"), v.Min(1)))
+	if err := v.ValidateStruct(&args, rules...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	target, errResult := s.resolveIssueOrCommentTarget(args.Repository, args.Directory, args.IssueNumber, args.CommentID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	attachment, err := client.GetAttachment(ctx, target, args.AttachmentID)
+	if err != nil {
+		return TextErrorf("Failed to get attachment: %v", err), nil, nil
+	}
+
+	data, err := client.DownloadAttachment(ctx, attachment, s.config.Attachment.MaxSize)
+	if err != nil {
+		return TextErrorf("Failed to download attachment: %v", err), nil, nil
+	}
+
+	mimeType := attachmentMIMEType(attachment.Name, data)
+	var responseText string
+	if s.compatMode {
+		responseText = FormatAttachment(attachment, mimeType)
+	} else {
+		responseText = fmt.Sprintf("Attachment %s (%d bytes, %s)", attachment.Name, len(data), mimeType)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: responseText},
+			attachmentContentFor(attachment, data, mimeType),
+		},
+	}, &AttachmentGetResult{Attachment: *attachment, MIMEType: mimeType}, nil
+}
+
+// attachmentMIMEType returns the MIME type of a file, by extension when known and by content otherwise
+func attachmentMIMEType(name string, data []byte) string {
+	if mimeType := mime.TypeByExtension(path.Ext(name)); mimeType != "" {
+		return mimeType
+	}
+	return http.DetectContentType(data)
+}
+
+// attachmentContentFor wraps downloaded data in the MCP content type matching its MIME type
+func attachmentContentFor(attachment *remote.Attachment, data []byte, mimeType string) mcp.Content {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = mimeType
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		return &mcp.ImageContent{Data: data, MIMEType: mediaType}
+	case isTextMediaType(mediaType) && utf8.Valid(data):
+		return &mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: attachment.DownloadURL, MIMEType: mimeType, Text: string(data)}}
+	default:
+		return &mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: attachment.DownloadURL, MIMEType: mimeType, Blob: data}}
+	}
+}
+
+// isTextMediaType reports whether a media type holds text, such as logs, JSON or XML
+func isTextMediaType(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "/json") || strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "/xml") || strings.HasSuffix(mediaType, "+xml")
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// attachmentContent is the wire form of the MCP content objects accepted as attachments
+type attachmentContent struct {
+	Type     string                `json:"type"`
+	Data     []byte                `json:"data"`
+	MIMEType string                `json:"mimeType"`
+	Resource *mcp.ResourceContents `json:"resource"`
+
+	// Set when the object is bare resource contents rather than an embedded resource
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+	Blob []byte `json:"blob"`
+}
+
+// processAttachments converts MCP content objects to files and checks them against the
+// attachment configuration. It returns nil when there are no attachments.
+func (s *Server) processAttachments(contents []any) ([]remote.ProcessedAttachment, error) {
+	if len(contents) == 0 {
+		return nil, nil
+	}
+	if !s.config.Attachment.Enabled {
+		return nil, fmt.Errorf("attachments are disabled (set attachment.enabled to permit uploads)")
+	}
+
+	files := make([]remote.ProcessedAttachment, 0, len(contents))
+	for i, content := range contents {
+		file, err := s.processAttachment(content)
+		if err != nil {
+			return nil, fmt.Errorf("attachment %d: %w", i, err)
+		}
+		if err := ValidateAttachment(file.Data, file.Filename, s.config.Attachment.MaxSize, s.config.Attachment.AllowedTypes); err != nil {
+			return nil, fmt.Errorf("attachment %d: %w", i, err)
+		}
+		files = append(files, *file)
+	}
+	return files, nil
+}
+
+// processAttachment converts an image, audio, embedded resource or resource contents object to a
+// file. Objects decoded from JSON arguments are converted to their MCP content type first.
+func (s *Server) processAttachment(content any) (*remote.ProcessedAttachment, error) {
+	if raw, ok := content.(map[string]any); ok {
+		converted, err := decodeAttachmentContent(raw)
+		if err != nil {
+			return nil, err
+		}
+		content = converted
+	}
+
+	switch c := content.(type) {
+	case *mcp.ImageContent:
+		return newProcessedAttachment(c.Data, "", c.MIMEType)
+	case *mcp.AudioContent:
+		return newProcessedAttachment(c.Data, "", c.MIMEType)
+	case *mcp.EmbeddedResource:
+		if c.Resource == nil {
+			return nil, fmt.Errorf("embedded resource has no contents")
+		}
+		return processResourceContents(c.Resource)
+	case *mcp.ResourceContents:
+		return processResourceContents(c)
+	default:
+		return nil, fmt.Errorf("unsupported content type: %T", content)
+	}
+}
+
+// decodeAttachmentContent converts a JSON-decoded content object to its MCP content type
+func decodeAttachmentContent(raw map[string]any) (any, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var wire attachmentContent
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return nil, fmt.Errorf("invalid content object: %w", err)
+	}
+
+	switch wire.Type {
+	case "image":
+		return &mcp.ImageContent{Data: wire.Data, MIMEType: wire.MIMEType}, nil
+	case "audio":
+		return &mcp.AudioContent{Data: wire.Data, MIMEType: wire.MIMEType}, nil
+	case "resource":
+		return &mcp.EmbeddedResource{Resource: wire.Resource}, nil
+	case "":
+		if wire.URI != "" {
+			return &mcp.ResourceContents{URI: wire.URI, MIMEType: wire.MIMEType, Text: wire.Text, Blob: wire.Blob}, nil
+		}
+		return nil, fmt.Errorf("content object has no type")
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", wire.Type)
+	}
+}
+
+// processResourceContents converts text or blob resource contents to a file named after the resource URI
+func processResourceContents(resource *mcp.ResourceContents) (*remote.ProcessedAttachment, error) {
+	data := resource.Blob
+	mimeType := resource.MIMEType
+	if data == nil {
+		data = []byte(resource.Text)
+		if mimeType == "" {
+			mimeType = "text/plain"
+		}
+	}
+	return newProcessedAttachment(data, resourceFilename(resource.URI), mimeType)
+}
+
+// newProcessedAttachment builds a file, generating a filename from the content when none is given
+func newProcessedAttachment(data []byte, filename, mimeType string) (*remote.ProcessedAttachment, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("content is empty")
+	}
+	if filename == "" {
+		filename = generateFilename(data, mimeType)
+	}
+	return &remote.ProcessedAttachment{
+		Data:     data,
+		Filename: filename,
+		MIMEType: mimeType,
+	}, nil
+}
+
+// resourceFilename returns the last path element of a resource URI, or "" when it has none
+func resourceFilename(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	p := parsed.Path
+	if p == "" {
+		p = parsed.Opaque
+	}
+	name := path.Base(p)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// generateFilename names unnamed content after a short hash of its data, so that several
+// attachments of the same type get distinct names
+func generateFilename(data []byte, mimeType string) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("attachment-%x%s", sum[:4], attachmentExtension(mimeType))
+}
+
+// attachmentExtension returns the file extension for common attachment MIME types
+func attachmentExtension(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/svg+xml":
+		return ".svg"
+	case "application/pdf":
+		return ".pdf"
+	case "application/zip":
+		return ".zip"
+	case "application/json":
+		return ".json"
+	case "text/plain":
+		return ".txt"
+	case "text/markdown":
+		return ".md"
+	case "audio/mpeg":
+		return ".mp3"
+	case "audio/wav":
+		return ".wav"
+	default:
+		return ".bin"
+	}
+}
+
+// uploadAttachments uploads files with upload and returns body with links to them appended
+func uploadAttachments(ctx context.Context, body string, files []remote.ProcessedAttachment, upload func(context.Context, remote.ProcessedAttachment) (*remote.Attachment, error)) (string, error) {
+	attachments := make([]remote.Attachment, 0, len(files))
+	for _, file := range files {
+		attachment, err := upload(ctx, file)
+		if err != nil {
+			return "", err
+		}
+		attachments = append(attachments, *attachment)
+	}
+	return remote.AppendAttachmentLinks(body, attachments), nil
+}
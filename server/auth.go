@@ -0,0 +1,168 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DefaultClientCacheSize is the number of per-token remote clients kept when
+// the configuration does not set client_cache_size.
+const DefaultClientCacheSize = 64
+
+// ClientFactory creates a remote client authenticated with the given token
+type ClientFactory func(token string) (remote.ClientInterface, error)
+
+// ClientCache is a bounded, least-recently-used cache of remote clients keyed
+// by authentication token. It lets every HTTP session act as its own Forgejo
+// user without rebuilding a client for each tool call.
+type ClientCache struct {
+	mu      sync.Mutex
+	size    int
+	factory ClientFactory
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+}
+
+// clientCacheEntry is the value stored in ClientCache.order
+type clientCacheEntry struct {
+	token  string
+	client remote.ClientInterface
+}
+
+// NewClientCache creates a cache holding at most size clients built by factory.
+// A size of zero or less uses DefaultClientCacheSize.
+func NewClientCache(size int, factory ClientFactory) *ClientCache {
+	if size <= 0 {
+		size = DefaultClientCacheSize
+	}
+	return &ClientCache{
+		size:    size,
+		factory: factory,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the client for token, creating it on first use and evicting the
+// least recently used client when the cache is full. Factory errors are not cached.
+func (c *ClientCache) Get(token string) (remote.ClientInterface, error) {
+	if token == "" {
+		return nil, fmt.Errorf("authentication token is required")
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.entries[token]; ok {
+		c.order.MoveToFront(elem)
+		client := elem.Value.(*clientCacheEntry).client
+		c.mu.Unlock()
+		return client, nil
+	}
+	c.mu.Unlock()
+
+	// Build the client outside the lock so slow client creation does not block other sessions
+	client, err := c.factory(token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Another request may have created the same client while the lock was released
+	if elem, ok := c.entries[token]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*clientCacheEntry).client, nil
+	}
+	c.entries[token] = c.order.PushFront(&clientCacheEntry{token: token, client: client})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*clientCacheEntry).token)
+	}
+	return client, nil
+}
+
+// Len returns the number of cached clients
+func (c *ClientCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// authTokenKey is the context key carrying a per-request authentication token
+type authTokenKey struct{}
+
+// WithAuthToken returns a copy of ctx carrying the given authentication token
+func WithAuthToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, authTokenKey{}, token)
+}
+
+// AuthTokenFromContext returns the authentication token stored in ctx, if any
+func AuthTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(authTokenKey{}).(string)
+	return token, ok && token != ""
+}
+
+// AuthTokenMiddleware stores the token from the request's Authorization header
+// in the request context. Both "token <value>" (Forgejo style) and
+// "Bearer <value>" schemes are accepted. Requests without a token pass through
+// unchanged.
+func AuthTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := parseAuthorization(r.Header.Get("Authorization")); token != "" {
+			r = r.WithContext(WithAuthToken(r.Context(), token))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseAuthorization extracts the token from an Authorization header value
+func parseAuthorization(header string) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok {
+		return ""
+	}
+	switch strings.ToLower(scheme) {
+	case "token", "bearer":
+		return strings.TrimSpace(token)
+	default:
+		return ""
+	}
+}
+
+// requestAuthToken returns the authentication token for a tool call. Headers
+// attached to the individual request (streamable HTTP) take precedence over the
+// token bound to the session context (SSE).
+func requestAuthToken(ctx context.Context, request *mcp.CallToolRequest) string {
+	if request != nil && request.Extra != nil && request.Extra.Header != nil {
+		if token := parseAuthorization(request.Extra.Header.Get("Authorization")); token != "" {
+			return token
+		}
+	}
+	token, _ := AuthTokenFromContext(ctx)
+	return token
+}
+
+// getRemoteClient returns the remote client for a tool call. With per-request
+// authentication enabled, calls carrying a token get that token's client from
+// the cache; all other calls use the server's default client.
+func (s *Server) getRemoteClient(ctx context.Context, request *mcp.CallToolRequest) (remote.ClientInterface, error) {
+	if s.clients != nil {
+		if token := requestAuthToken(ctx, request); token != "" {
+			return s.clients.Get(token)
+		}
+		if s.remote == nil {
+			return nil, fmt.Errorf("no authentication token provided for this request")
+		}
+	}
+	if s.remote == nil {
+		return nil, fmt.Errorf("remote client not initialized")
+	}
+	return s.remote, nil
+}
@@ -0,0 +1,212 @@
+package server_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kunde21/forgejo-mcp/config"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/kunde21/forgejo-mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestClientCache_ReusesClientPerToken(t *testing.T) {
+	calls := 0
+	cache := server.NewClientCache(2, func(token string) (remote.ClientInterface, error) {
+		calls++
+		return nil, nil
+	})
+
+	for range 3 {
+		if _, err := cache.Get("alice"); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected factory to be called once, got %d", calls)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Expected 1 cached client, got %d", cache.Len())
+	}
+}
+
+func TestClientCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	created := map[string]int{}
+	cache := server.NewClientCache(2, func(token string) (remote.ClientInterface, error) {
+		created[token]++
+		return nil, nil
+	})
+
+	cache.Get("alice")
+	cache.Get("bob")
+	cache.Get("alice") // bob is now least recently used
+	cache.Get("carol") // evicts bob
+
+	if cache.Len() != 2 {
+		t.Errorf("Expected cache to be bounded at 2, got %d", cache.Len())
+	}
+	cache.Get("alice")
+	cache.Get("bob")
+	if created["alice"] != 1 {
+		t.Errorf("Expected alice to stay cached, created %d times", created["alice"])
+	}
+	if created["bob"] != 2 {
+		t.Errorf("Expected bob to be evicted and recreated, created %d times", created["bob"])
+	}
+}
+
+func TestClientCache_DoesNotCacheErrors(t *testing.T) {
+	fail := true
+	cache := server.NewClientCache(2, func(token string) (remote.ClientInterface, error) {
+		if fail {
+			return nil, errors.New("boom")
+		}
+		return nil, nil
+	})
+
+	if _, err := cache.Get("alice"); err == nil {
+		t.Fatal("Expected factory error")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Expected failed client not to be cached, got %d entries", cache.Len())
+	}
+	fail = false
+	if _, err := cache.Get("alice"); err != nil {
+		t.Errorf("Expected retry to succeed, got: %v", err)
+	}
+	if _, err := cache.Get(""); err == nil {
+		t.Error("Expected error for empty token")
+	}
+}
+
+// headerTransport adds an Authorization header to every outgoing request
+type headerTransport struct {
+	token string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newPerRequestAuthServer creates a per-request auth server whose stub remote records
+// the Authorization header seen on the issues endpoint
+func newPerRequestAuthServer(t *testing.T) (*server.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var seen []string
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/version":
+			w.Write([]byte(`{"version": "1.20.0"}`))
+		case "/api/v1/repos/owner/repo/issues":
+			mu.Lock()
+			seen = append(seen, r.Header.Get("Authorization"))
+			mu.Unlock()
+			w.Write([]byte(`[]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(remote.Close)
+
+	s, err := server.NewFromConfig(&config.Config{
+		RemoteURL:      remote.URL,
+		ClientType:     "gitea",
+		PerRequestAuth: true,
+	})
+	if err != nil {
+		t.Fatalf("NewFromConfig failed: %v", err)
+	}
+	return s, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), seen...)
+	}
+}
+
+func callIssueList(t *testing.T, session *mcp.ClientSession) *mcp.CallToolResult {
+	t.Helper()
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "issue_list",
+		Arguments: map[string]any{"repository": "owner/repo", "limit": 10},
+	})
+	if err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+	return result
+}
+
+func TestPerRequestAuth_StreamableHTTP(t *testing.T) {
+	s, seen := newPerRequestAuthServer(t)
+	httpServer := httptest.NewServer(s.StreamableHTTPHandler())
+	t.Cleanup(httpServer.Close)
+
+	for _, token := range []string{"alice-token", "bob-token"} {
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+		transport := mcp.NewStreamableClientTransport(httpServer.URL, &mcp.StreamableClientTransportOptions{
+			HTTPClient: &http.Client{Transport: &headerTransport{token: token}},
+		})
+		session, err := client.Connect(t.Context(), transport, nil)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		if result := callIssueList(t, session); result.IsError {
+			t.Errorf("Expected issue_list to succeed for %s, got: %+v", token, result.Content)
+		}
+		session.Close()
+	}
+
+	got := seen()
+	if len(got) != 2 || got[0] != "token alice-token" || got[1] != "token bob-token" {
+		t.Errorf("Expected each session to use its own token, got %q", got)
+	}
+}
+
+func TestPerRequestAuth_SSE(t *testing.T) {
+	s, seen := newPerRequestAuthServer(t)
+	httpServer := httptest.NewServer(s.SSEHandler())
+	t.Cleanup(httpServer.Close)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	transport := mcp.NewSSEClientTransport(httpServer.URL, &mcp.SSEClientTransportOptions{
+		HTTPClient: &http.Client{Transport: &headerTransport{token: "carol-token"}},
+	})
+	session, err := client.Connect(t.Context(), transport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	if result := callIssueList(t, session); result.IsError {
+		t.Errorf("Expected issue_list to succeed, got: %+v", result.Content)
+	}
+	if got := seen(); len(got) != 1 || got[0] != "token carol-token" {
+		t.Errorf("Expected session token to be used, got %q", got)
+	}
+}
+
+func TestPerRequestAuth_MissingToken(t *testing.T) {
+	s, seen := newPerRequestAuthServer(t)
+	httpServer := httptest.NewServer(s.StreamableHTTPHandler())
+	t.Cleanup(httpServer.Close)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(t.Context(), mcp.NewStreamableClientTransport(httpServer.URL, nil), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	if result := callIssueList(t, session); !result.IsError {
+		t.Error("Expected issue_list without a token to fail")
+	}
+	if got := seen(); len(got) != 0 {
+		t.Errorf("Expected no remote calls without a token, got %q", got)
+	}
+}
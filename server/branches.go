@@ -0,0 +1,401 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultCompareLimit is the number of commits listed per direction when no limit is given
+const defaultCompareLimit = 50
+
+// BranchListArgs represents the arguments for listing repository branches
+type BranchListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of branches to return
+	Offset     int    `json:"offset,omitzero"`     // Number of branches to skip
+}
+
+// BranchList represents the result data for the branch_list tool
+type BranchList struct {
+	Branches []remote.Branch `json:"branches"`
+	Total    int             `json:"total"`
+	Limit    int             `json:"limit"`
+	Offset   int             `json:"offset"`
+}
+
+// BranchCreateArgs represents the arguments for creating a branch
+type BranchCreateArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Name       string `json:"name"`                // Name of the new branch
+	Ref        string `json:"ref,omitzero"`        // Branch, tag or commit SHA to start from (default: the default branch)
+}
+
+// BranchResult represents the result data for the branch_create tool
+type BranchResult struct {
+	Branch *remote.Branch `json:"branch,omitempty"`
+}
+
+// BranchDeleteArgs represents the arguments for deleting a branch
+type BranchDeleteArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Name       string `json:"name"`                // Name of the branch to delete
+}
+
+// BranchDeleteResult represents the result data for the branch_delete tool
+type BranchDeleteResult struct {
+	Name    string `json:"name"`
+	Deleted bool   `json:"deleted"`
+}
+
+// BranchCompareArgs represents the arguments for comparing two branches
+type BranchCompareArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Base       string `json:"base"`                // Branch to compare against
+	Head       string `json:"head"`                // Branch to compare
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of commits listed per direction (1-500, default 50)
+}
+
+// BranchCompareResult represents the result data for the branch_compare tool
+type BranchCompareResult struct {
+	Comparison *remote.BranchComparison `json:"comparison,omitempty"`
+}
+
+// handleBranchList handles the "branch_list" tool request.
+// It lists the branches of the remote repository with their last commit and protection status.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - limit: Maximum number of branches to return (1-100, default 15)
+//   - offset: Number of branches to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The repository branches with pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleBranchList(ctx context.Context, request *mcp.CallToolRequest, args BranchListArgs) (*mcp.CallToolResult, *BranchList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	branches, err := client.ListBranches(ctx, repository, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list branches: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatBranchList(branches.Branches)
+	} else {
+		responseText = fmt.Sprintf("Found %d branches", len(branches.Branches))
+	}
+
+	return TextResult(responseText), &BranchList{
+		Branches: branches.Branches,
+		Total:    branches.Total,
+		Limit:    branches.Limit,
+		Offset:   branches.Offset,
+	}, nil
+}
+
+// handleBranchCreate handles the "branch_create" tool request.
+// It creates a branch on the remote repository from a branch, tag or commit.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - name: The name of the new branch
+//   - ref: Branch, tag or commit SHA to start from (optional, defaults to the default branch)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The branch is created on
+// the remote only; fetch it to use it locally.
+//
+// Returns:
+//   - Success: The created branch with its commit
+//   - Error: Validation errors, existing branches, unknown refs, or API failures
+func (s *Server) handleBranchCreate(ctx context.Context, request *mcp.CallToolRequest, args BranchCreateArgs) (*mcp.CallToolResult, *BranchResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Name, v.Required.Error("branch name is required"), v.Length(1, 100)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	branch, err := client.CreateBranch(ctx, repository, args.Name, args.Ref)
+	if err != nil {
+		return TextErrorf("Failed to create branch: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatBranch(branch)
+	} else {
+		responseText = fmt.Sprintf("Created branch %s at %s", branch.Name, branch.Commit.SHA)
+	}
+
+	return TextResult(responseText), &BranchResult{Branch: branch}, nil
+}
+
+// handleBranchDelete handles the "branch_delete" tool request.
+// It deletes a branch from the remote repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - name: The name of the branch to delete
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The server refuses to
+// delete the default branch and protected branches.
+//
+// Returns:
+//   - Success: The name of the deleted branch
+//   - Error: Validation errors, protected branches, or API failures
+func (s *Server) handleBranchDelete(ctx context.Context, request *mcp.CallToolRequest, args BranchDeleteArgs) (*mcp.CallToolResult, *BranchDeleteResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Name, v.Required.Error("branch name is required")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	if err := client.DeleteBranch(ctx, repository, args.Name); err != nil {
+		return TextErrorf("Failed to delete branch: %v", err), nil, nil
+	}
+
+	return TextResultf("Deleted branch %s", args.Name), &BranchDeleteResult{Name: args.Name, Deleted: true}, nil
+}
+
+// handleBranchCompare handles the "branch_compare" tool request.
+// It compares two branches on the remote, counting and listing the commits each has that
+// the other lacks, without needing either branch locally.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - base: The branch to compare against
+//   - head: The branch to compare
+//   - limit: Maximum number of commits listed per direction (1-500, default 50)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The ahead and behind
+// counts are exact even when the commit lists are cut off by the limit.
+//
+// Returns:
+//   - Success: The ahead and behind counts with the differing commits
+//   - Error: Validation errors, unknown branches, or API failures
+func (s *Server) handleBranchCompare(ctx context.Context, request *mcp.CallToolRequest, args BranchCompareArgs) (*mcp.CallToolResult, *BranchCompareResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = defaultCompareLimit
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Base, v.Required.Error("base branch is required")),
+		v.Field(&args.Head, v.Required.Error("head branch is required")),
+		v.Field(&args.Limit, v.Min(1), v.Max(500)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	comparison, err := client.CompareBranches(ctx, repository, args.Base, args.Head, args.Limit)
+	if err != nil {
+		return TextErrorf("Failed to compare branches: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatBranchComparison(comparison)
+	} else {
+		responseText = fmt.Sprintf("%s is %d commits ahead of and %d commits behind %s", comparison.Head, comparison.AheadBy, comparison.BehindBy, comparison.Base)
+	}
+
+	return TextResult(responseText), &BranchCompareResult{Comparison: comparison}, nil
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// IssueCommentDeleteArgs represents the arguments for deleting an issue comment
+type IssueCommentDeleteArgs struct {
+	Repository  string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	IssueNumber int    `json:"issue_number"`
+	CommentID   int    `json:"comment_id"`
+}
+
+// PullRequestCommentDeleteArgs represents the arguments for deleting a pull request comment
+type PullRequestCommentDeleteArgs struct {
+	Repository        string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	PullRequestNumber int    `json:"pull_request_number"`
+	CommentID         int    `json:"comment_id"`
+}
+
+// CommentDeleteResult represents the result data for the issue_comment_delete and pr_comment_delete tools
+type CommentDeleteResult struct {
+	CommentID int  `json:"comment_id"`
+	Deleted   bool `json:"deleted"`
+}
+
+// handleIssueCommentDelete handles the "issue_comment_delete" tool request.
+// It deletes a comment from a Forgejo/Gitea issue.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue number containing the comment (must be positive)
+//   - comment_id: The ID of the comment to delete (must be positive)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Comments written by other
+// users are refused unless allow_delete_others_comments is enabled in the configuration.
+//
+// Returns:
+//   - Success: Deletion confirmation with the comment ID
+//   - Error: Validation errors, ownership refusal, or API failures
+func (s *Server) handleIssueCommentDelete(ctx context.Context, request *mcp.CallToolRequest, args IssueCommentDeleteArgs) (*mcp.CallToolResult, *CommentDeleteResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.IssueNumber, v.Required.Error("must be no less than 1"), v.Min(1)),
+		v.Field(&args.CommentID, v.Required.Error("must be no less than 1"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	return s.deleteComment(ctx, request, args.Repository, args.Directory, args.IssueNumber, args.CommentID)
+}
+
+// handlePullRequestCommentDelete handles the "pr_comment_delete" tool request.
+// It deletes a comment from a Forgejo/Gitea pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number containing the comment (must be positive)
+//   - comment_id: The ID of the comment to delete (must be positive)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Comments written by other
+// users are refused unless allow_delete_others_comments is enabled in the configuration.
+//
+// Returns:
+//   - Success: Deletion confirmation with the comment ID
+//   - Error: Validation errors, ownership refusal, or API failures
+func (s *Server) handlePullRequestCommentDelete(ctx context.Context, request *mcp.CallToolRequest, args PullRequestCommentDeleteArgs) (*mcp.CallToolResult, *CommentDeleteResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("must be no less than 1"), v.Min(1)),
+		v.Field(&args.CommentID, v.Required.Error("must be no less than 1"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	return s.deleteComment(ctx, request, args.Repository, args.Directory, args.PullRequestNumber, args.CommentID)
+}
+
+// deleteComment deletes a validated issue or pull request comment.
+// The comment must belong to the issue or pull request with the given number.
+func (s *Server) deleteComment(ctx context.Context, request *mcp.CallToolRequest, repository, directory string, number, commentID int) (*mcp.CallToolResult, *CommentDeleteResult, error) {
+	if directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	err = client.DeleteComment(ctx, remote.DeleteCommentArgs{
+		Repository:  repository,
+		IssueNumber: number,
+		CommentID:   commentID,
+		AllowOthers: s.config.AllowDeleteOthersComments,
+	})
+	if errors.Is(err, remote.ErrNotCommentAuthor) {
+		return TextErrorf("Refusing to delete comment: %v (set allow_delete_others_comments to permit this)", err), nil, nil
+	}
+	if err != nil {
+		return TextErrorf("Failed to delete comment: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatCommentDeleteSuccess(commentID, number)
+	} else {
+		responseText = fmt.Sprintf("Comment %d deleted", commentID)
+	}
+
+	return TextResult(responseText), &CommentDeleteResult{CommentID: commentID, Deleted: true}, nil
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// CheckStateNone is reported when a commit has no CI checks
+const CheckStateNone = "none"
+
+// CommitStatusArgs represents the arguments for fetching the CI status of a commit
+type CommitStatusArgs struct {
+	Repository        string `json:"repository,omitzero"`          // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`           // Local directory path for automatic resolution
+	Ref               string `json:"ref,omitzero"`                 // Branch, tag, or commit SHA
+	PullRequestNumber int    `json:"pull_request_number,omitzero"` // Use the head commit of this pull request
+}
+
+// CheckSummary summarizes the CI checks reported for a commit
+type CheckSummary struct {
+	State   string `json:"state"` // Combined state, or "none" when no checks were reported
+	SHA     string `json:"sha"`
+	Total   int    `json:"total"`
+	Success int    `json:"success"`
+	Pending int    `json:"pending"`
+	Failed  int    `json:"failed"` // Checks in the failure or error state
+	Warning int    `json:"warning"`
+}
+
+// CommitStatusResult represents the result data for the commit_status tool
+type CommitStatusResult struct {
+	Status  *remote.CombinedCommitStatus `json:"status,omitempty"`
+	Summary *CheckSummary                `json:"summary,omitempty"`
+}
+
+// summarizeCommitStatus counts the individual checks of a combined status by state.
+// The total reports every check, while the per-state counts cover the returned checks.
+func summarizeCommitStatus(status *remote.CombinedCommitStatus) *CheckSummary {
+	summary := &CheckSummary{
+		State: status.State,
+		SHA:   status.SHA,
+		Total: status.TotalCount,
+	}
+	if summary.Total == 0 {
+		summary.State = CheckStateNone
+	}
+	for _, check := range status.Statuses {
+		switch check.State {
+		case remote.CommitStatusSuccess:
+			summary.Success++
+		case remote.CommitStatusPending:
+			summary.Pending++
+		case remote.CommitStatusFailure, remote.CommitStatusError:
+			summary.Failed++
+		case remote.CommitStatusWarning:
+			summary.Warning++
+		}
+	}
+	return summary
+}
+
+// handleCommitStatus handles the "commit_status" tool request.
+// It reports the combined CI state and individual check contexts for a ref or the head of a pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - ref: Branch, tag, or commit SHA to inspect
+//   - pull_request_number: Pull request whose head commit is inspected
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of ref
+// or pull_request_number must be provided.
+//
+// Returns:
+//   - Success: The combined status with each check context and a count by state
+//   - Error: Validation errors or API failures
+func (s *Server) handleCommitStatus(ctx context.Context, request *mcp.CallToolRequest, args CommitStatusArgs) (*mcp.CallToolResult, *CommitStatusResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Ref,
+			v.When(args.PullRequestNumber == 0,
+				v.Required.Error("one of ref or pull_request_number is required"),
+				v.Match(emptyReg).Error("ref cannot be only whitespace"),
+			),
+			v.When(args.PullRequestNumber != 0, v.Empty.Error("only one of ref or pull_request_number may be set")),
+		),
+		v.Field(&args.PullRequestNumber, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	ref := args.Ref
+	if args.PullRequestNumber != 0 {
+		// Resolve the pull request head commit
+		pr, err := client.GetPullRequest(ctx, repository, args.PullRequestNumber)
+		if err != nil {
+			return TextErrorf("Failed to fetch pull request: %v", err), nil, nil
+		}
+		ref = pr.Head.Sha
+		if ref == "" {
+			return TextErrorf("Pull request #%d has no head commit", args.PullRequestNumber), nil, nil
+		}
+	}
+
+	// Fetch the combined status
+	status, err := client.GetCombinedCommitStatus(ctx, repository, ref)
+	if err != nil {
+		return TextErrorf("Failed to get commit status: %v", err), nil, nil
+	}
+	summary := summarizeCommitStatus(status)
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatCommitStatus(status, summary)
+	} else {
+		responseText = fmt.Sprintf("CI state for %s: %s (%d checks)", ref, summary.State, summary.Total)
+	}
+
+	return TextResult(responseText), &CommitStatusResult{Status: status, Summary: summary}, nil
+}
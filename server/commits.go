@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// CommitListArgs represents the arguments for listing commits
+type CommitListArgs struct {
+	Repository        string `json:"repository,omitzero"`          // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`           // Local directory path for automatic resolution
+	Branch            string `json:"branch,omitzero"`              // Branch, tag or commit SHA to list from (default: the default branch)
+	Path              string `json:"path,omitzero"`                // Only commits changing this file or directory
+	Author            string `json:"author,omitzero"`              // Only commits by this author name, email or username
+	Since             string `json:"since,omitzero"`               // Only commits at or after this date (YYYY-MM-DD or RFC 3339)
+	Until             string `json:"until,omitzero"`               // Only commits before this date (YYYY-MM-DD or RFC 3339)
+	PullRequestNumber int    `json:"pull_request_number,omitzero"` // List the commits of this pull request instead
+	Limit             int    `json:"limit,omitzero"`               // Maximum number of commits to return
+	Offset            int    `json:"offset,omitzero"`              // Number of commits to skip
+}
+
+// CommitList represents the result data for the commit_list tool
+type CommitList struct {
+	Commits     []remote.Commit `json:"commits"`
+	Total       *int            `json:"total,omitempty"` // Omitted when an author search stopped before the end of the history
+	Limit       int             `json:"limit"`
+	Offset      int             `json:"offset"`
+	ScanLimited bool            `json:"scan_limited,omitempty"` // Only the 1000 most recent commits were searched for the author
+}
+
+// CommitGetArgs represents the arguments for inspecting a single commit
+type CommitGetArgs struct {
+	Repository      string `json:"repository,omitzero"`         // Repository path in "owner/repo" format
+	Directory       string `json:"directory,omitzero"`          // Local directory path for automatic resolution
+	SHA             string `json:"sha"`                         // Commit SHA, or a branch or tag name for its latest commit
+	IncludeDiff     bool   `json:"include_diff,omitzero"`       // Include the unified diff of the commit
+	MaxLinesPerFile int    `json:"max_lines_per_file,omitzero"` // Truncate each file's diff after this many lines
+}
+
+// CommitGetResult represents the result data for the commit_get tool
+type CommitGetResult struct {
+	Commit    remote.Commit     `json:"commit"`
+	Diff      string            `json:"diff,omitempty"`
+	DiffFiles []DiffFileSummary `json:"diff_files,omitempty"`
+}
+
+// handleCommitList handles the "commit_list" tool request.
+// It lists the commits of the remote repository, newest first, optionally filtered by
+// path, author and date, or the commits of a pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - branch: Branch, tag or commit SHA to list from (optional, defaults to the default branch)
+//   - path: Only commits changing this file or directory (optional)
+//   - author: Only commits by this author name, email or username (optional)
+//   - since: Only commits at or after this date, YYYY-MM-DD or RFC 3339 (optional)
+//   - until: Only commits before this date, YYYY-MM-DD or RFC 3339 (optional)
+//   - pull_request_number: List the commits of this pull request instead (optional)
+//   - limit: Maximum number of commits to return (1-100, default 15)
+//   - offset: Number of commits to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The server cannot filter
+// by author, so only the 1000 most recent commits matching the other filters are searched
+// for the author; the total is omitted unless that search reaches the end of the history, and
+// scan_limited is set when it stopped first. pull_request_number cannot be combined with the
+// other filters.
+//
+// Returns:
+//   - Success: The commits with pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleCommitList(ctx context.Context, request *mcp.CallToolRequest, args CommitListArgs) (*mcp.CallToolResult, *CommitList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	prFilter := v.When(args.PullRequestNumber != 0, v.Empty.Error("cannot be combined with pull_request_number"))
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Branch, prFilter),
+		v.Field(&args.Path, prFilter),
+		v.Field(&args.Author, prFilter),
+		v.Field(&args.Since, prFilter, dateRule("since")),
+		v.Field(&args.Until, prFilter, dateRule("until")),
+		v.Field(&args.PullRequestNumber, v.Min(0)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	var commits *remote.CommitList
+	if args.PullRequestNumber != 0 {
+		commits, err = client.ListPullRequestCommits(ctx, repository, args.PullRequestNumber, args.Limit, args.Offset)
+	} else {
+		options := remote.ListCommitsOptions{
+			Ref:    args.Branch,
+			Path:   strings.Trim(args.Path, "/"),
+			Author: args.Author,
+			Limit:  args.Limit,
+			Offset: args.Offset,
+		}
+		if since, _ := parseDate(args.Since); since != nil {
+			options.Since = *since
+		}
+		if until, _ := parseDate(args.Until); until != nil {
+			options.Until = *until
+		}
+		commits, err = client.ListCommits(ctx, repository, options)
+	}
+	if err != nil {
+		return TextErrorf("Failed to list commits: %v", err), nil, nil
+	}
+
+	var responseText string
+	switch {
+	case s.compatMode:
+		responseText = FormatCommitList(commits.Commits)
+	case commits.ScanLimited:
+		responseText = fmt.Sprintf("Found %d commits in the 1000 most recent commits searched for the author; older commits were not searched", len(commits.Commits))
+	default:
+		responseText = fmt.Sprintf("Found %d commits", len(commits.Commits))
+	}
+
+	return TextResult(responseText), &CommitList{
+		Commits:     commits.Commits,
+		Total:       commits.Total,
+		Limit:       commits.Limit,
+		Offset:      commits.Offset,
+		ScanLimited: commits.ScanLimited,
+	}, nil
+}
+
+// handleCommitGet handles the "commit_get" tool request.
+// It fetches a single commit of the remote repository with its message, author, stats and
+// changed files, optionally with its diff truncated per file so large commits fit a model context.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - sha: The commit SHA, or a branch or tag name for its latest commit
+//   - include_diff: Include the unified diff of the commit (optional, default false)
+//   - max_lines_per_file: Maximum diff lines per file before truncation (1-10000, default 500)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The commit details, with the diff when requested
+//   - Error: Validation errors, unknown commits, or API failures
+func (s *Server) handleCommitGet(ctx context.Context, request *mcp.CallToolRequest, args CommitGetArgs) (*mcp.CallToolResult, *CommitGetResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default line limit if not provided
+	if args.MaxLinesPerFile == 0 {
+		args.MaxLinesPerFile = defaultDiffMaxLinesPerFile
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.SHA, v.Required.Error("commit sha is required")),
+		v.Field(&args.MaxLinesPerFile, v.Min(1), v.Max(10000)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	commit, err := client.GetCommit(ctx, repository, args.SHA)
+	if err != nil {
+		return TextErrorf("Failed to get commit: %v", err), nil, nil
+	}
+
+	result := &CommitGetResult{Commit: *commit}
+	if args.IncludeDiff {
+		// Diff by the resolved SHA so a moving branch cannot return another commit's diff
+		diff, err := client.GetCommitDiff(ctx, repository, commit.SHA)
+		if err != nil {
+			return TextErrorf("Failed to get commit diff: %v", err), nil, nil
+		}
+		var builder strings.Builder
+		for _, file := range splitDiff(diff) {
+			text, lines, truncated := truncateFileDiff(file, args.MaxLinesPerFile)
+			builder.WriteString(text)
+			result.DiffFiles = append(result.DiffFiles, DiffFileSummary{Path: file.path, Lines: lines, Truncated: truncated})
+		}
+		result.Diff = builder.String()
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatCommit(result)
+	} else {
+		subject, _, _ := strings.Cut(commit.Message, "\n")
+		responseText = fmt.Sprintf("Commit %s by %s: %s", commit.SHA, commit.Author, subject)
+	}
+
+	return TextResult(responseText), result, nil
+}
@@ -6,7 +6,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
+	v "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -30,8 +32,34 @@ func TextErrorf(format string, args ...any) *mcp.CallToolResult {
 var (
 	repoReg  = regexp.MustCompile(`^[a-zA-Z0-9._-]+/[a-zA-Z0-9._-]+$`)
 	emptyReg = regexp.MustCompilePOSIX(`[^[:space:]]+`)
+	colorReg = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+	userReg  = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
 )
 
+// parseDate parses a date given as a calendar date (YYYY-MM-DD) or an RFC 3339 timestamp.
+// Calendar dates are interpreted as midnight UTC.
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("must be YYYY-MM-DD or RFC 3339")
+}
+
+// dateRule validates a date argument accepted by parseDate, naming it in the error message
+func dateRule(name string) v.Rule {
+	return v.By(func(value any) error {
+		if _, err := parseDate(value.(string)); err != nil {
+			return v.NewError("date", name+" "+err.Error())
+		}
+		return nil
+	})
+}
+
 // ValidateAttachment validates file data, filename, and size
 func ValidateAttachment(data []byte, filename string, maxSize int64, allowedTypes []string) error {
 	// Size validation
@@ -53,9 +81,17 @@ func ValidateAttachment(data []byte, filename string, maxSize int64, allowedType
 	return nil
 }
 
+// isAllowedMimeType reports whether the media type of mimeType, ignoring parameters such as the
+// charset, equals an allowed type or, for allowed types ending in "*", starts with it
 func isAllowedMimeType(mimeType string, allowedTypes []string) bool {
+	mediaType, _, _ := strings.Cut(mimeType, ";")
+	mediaType = strings.TrimSpace(mediaType)
 	for _, allowed := range allowedTypes {
-		if allowed == "*" || strings.HasPrefix(mimeType, allowed) {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			if strings.HasPrefix(mediaType, prefix) {
+				return true
+			}
+		} else if mediaType == allowed {
 			return true
 		}
 	}
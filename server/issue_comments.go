@@ -21,6 +21,7 @@ type IssueCommentArgs struct {
 	Directory   string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
 	IssueNumber int    `json:"issue_number"`
 	Comment     string `json:"comment"`
+	Attachments []any  `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects
 }
 
 // handleIssueCommentCreate handles the "issue_comment_create" tool request.
@@ -84,12 +85,44 @@ func (s *Server) handleIssueCommentCreate(ctx context.Context, request *mcp.Call
 		repository = resolution.Repository
 	}
 
+	// Process attachments
+	files, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Create the comment using the service layer
-	comment, err := s.remote.CreateIssueComment(ctx, repository, args.IssueNumber, args.Comment)
+	comment, err := client.CreateIssueComment(ctx, repository, args.IssueNumber, args.Comment)
 	if err != nil {
 		return TextErrorf("Failed to create comment: %v", err), nil, nil
 	}
 
+	// Upload attachments to the new comment and link them from its body
+	if len(files) > 0 {
+		content, err := uploadAttachments(ctx, comment.Content, files, func(ctx context.Context, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+			return client.UploadCommentAttachment(ctx, repository, comment.ID, file)
+		})
+		if err != nil {
+			return TextErrorf("Comment %d was created but attaching files failed: %v", comment.ID, err), nil, nil
+		}
+		edited, err := client.EditIssueComment(ctx, remote.EditIssueCommentArgs{
+			Repository:  repository,
+			IssueNumber: args.IssueNumber,
+			CommentID:   comment.ID,
+			NewContent:  content,
+		})
+		if err != nil {
+			return TextErrorf("Comment %d was created but linking attachments failed: %v", comment.ID, err), nil, nil
+		}
+		comment = edited
+	}
+
 	var responseText string
 	if s.compatMode {
 		responseText = fmt.Sprintf("Comment created successfully. ID: %d, Created: %s\nComment body: %s",
@@ -182,8 +215,14 @@ func (s *Server) handleIssueCommentList(ctx context.Context, request *mcp.CallTo
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Fetch comments from the Gitea/Forgejo repository
-	commentList, err := s.remote.ListIssueComments(ctx, repository, args.IssueNumber, args.Limit, args.Offset)
+	commentList, err := client.ListIssueComments(ctx, repository, args.IssueNumber, args.Limit, args.Offset)
 	if err != nil {
 		return TextErrorf("Failed to list issue comments: %v", err), nil, nil
 	}
@@ -200,6 +239,9 @@ func (s *Server) handleIssueCommentList(ctx context.Context, request *mcp.CallTo
 				endIndex)
 			for i, comment := range commentList.Comments {
 				responseText += fmt.Sprintf("Comment %d (ID: %d): %s\n", i+1, comment.ID, comment.Content)
+				if len(comment.Reactions) > 0 {
+					responseText += fmt.Sprintf("  Reactions: %s\n", FormatReactionSummary(comment.Reactions))
+				}
 			}
 		}
 	} else {
@@ -300,8 +342,14 @@ func (s *Server) handleIssueCommentEdit(ctx context.Context, request *mcp.CallTo
 		NewContent:  args.NewContent,
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Edit the comment using the service layer
-	comment, err := s.remote.EditIssueComment(ctx, serviceArgs)
+	comment, err := client.EditIssueComment(ctx, serviceArgs)
 	if err != nil {
 		return TextErrorf("Failed to edit comment: %v", err), nil, nil
 	}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// IssueFetchArgs represents the arguments for fetching an issue
+type IssueFetchArgs struct {
+	Repository  string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	IssueNumber int    `json:"issue_number"`
+}
+
+// IssueFetchResult represents the result data for the issue_fetch tool
+type IssueFetchResult struct {
+	Issue *remote.IssueDetails `json:"issue,omitempty"`
+}
+
+// handleIssueFetch handles the "issue_fetch" tool request.
+// It retrieves detailed information about a single issue from a Forgejo/Gitea repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue number to fetch (must be positive)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Pull requests can be
+// fetched as issues as well; the result then links the pull request.
+//
+// Returns:
+//   - Success: Detailed issue information including labels, milestone, assignees, and reactions
+//   - Error: Validation errors or API failures
+func (s *Server) handleIssueFetch(ctx context.Context, request *mcp.CallToolRequest, args IssueFetchArgs) (*mcp.CallToolResult, *IssueFetchResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.IssueNumber, v.Required.Error("issue number is required"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Fetch the issue
+	issue, err := client.GetIssue(ctx, repository, args.IssueNumber)
+	if err != nil {
+		return TextErrorf("Failed to fetch issue: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatIssueFetch(issue)
+	} else {
+		responseText = fmt.Sprintf("Issue #%d: %s (%s)", issue.Number, issue.Title, issue.State)
+	}
+
+	return TextResult(responseText), &IssueFetchResult{Issue: issue}, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// SearchIssuesArgs represents the arguments for searching issues across repositories
+type SearchIssuesArgs struct {
+	State       string   `json:"state,omitzero"`       // Filter by state: "open", "closed", or "all"
+	Type        string   `json:"type,omitzero"`        // Filter by type: "issues", "pulls", or "all"
+	Owner       string   `json:"owner,omitzero"`       // Limit to repositories of this user or organization
+	Team        string   `json:"team,omitzero"`        // Limit to repositories of this organization team; requires owner
+	Involvement string   `json:"involvement,omitzero"` // Relation to the authenticated user, e.g. "assigned" or "review_requested"
+	Labels      []string `json:"labels,omitzero"`      // Label names that must all be present
+	Milestone   string   `json:"milestone,omitzero"`   // Milestone title
+	Query       string   `json:"query,omitzero"`       // Keyword searched in title and body
+	Since       string   `json:"since,omitzero"`       // Only issues updated at or after this date (YYYY-MM-DD or RFC 3339)
+	Before      string   `json:"before,omitzero"`      // Only issues updated before this date (YYYY-MM-DD or RFC 3339)
+	Limit       int      `json:"limit,omitzero"`
+	Offset      int      `json:"offset,omitzero"`
+}
+
+// handleSearchIssues handles the "search_issues" tool request.
+// It searches issues and pull requests across all repositories visible to the authenticated user.
+//
+// Parameters:
+//   - state: Filter by state: "open", "closed", or "all" (default "open")
+//   - type: Filter by type: "issues", "pulls", or "all" (default "all")
+//   - owner: Limit to repositories of this user or organization
+//   - team: Limit to repositories of this organization team (requires owner)
+//   - involvement: "assigned", "created", "mentioned", "review_requested", or "reviewed",
+//     relative to the authenticated user
+//   - labels: Label names that must all be present
+//   - milestone: Milestone title
+//   - query: Keyword searched in title and body
+//   - since: Only issues updated at or after this date (YYYY-MM-DD or RFC 3339)
+//   - before: Only issues updated before this date (YYYY-MM-DD or RFC 3339)
+//   - limit: Maximum number of issues to return (1-100, default 15)
+//   - offset: Number of issues to skip for pagination (default 0)
+//
+// Returns:
+//   - Success: Matching issues, each tagged with its repository
+//   - Error: Validation errors or API failures
+func (s *Server) handleSearchIssues(ctx context.Context, request *mcp.CallToolRequest, args SearchIssuesArgs) (*mcp.CallToolResult, *IssueList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.State, v.In("open", "closed", "all").Error("state must be 'open', 'closed', or 'all'")),
+		v.Field(&args.Type, v.In("issues", "pulls", "all").Error("type must be 'issues', 'pulls', or 'all'")),
+		v.Field(&args.Owner,
+			v.When(args.Team != "", v.Required.Error("owner is required when team is set")),
+			v.Match(userReg).Error("owner must be a valid user or organization name"),
+		),
+		v.Field(&args.Team, v.Match(userReg).Error("team must be a valid team name")),
+		v.Field(&args.Involvement, v.In(
+			remote.InvolvementAssigned, remote.InvolvementCreated, remote.InvolvementMentioned,
+			remote.InvolvementReviewRequested, remote.InvolvementReviewed,
+		).Error("involvement must be one of assigned, created, mentioned, review_requested, or reviewed")),
+		v.Field(&args.Labels, v.Each(
+			v.Required.Error("label cannot be empty"),
+			v.Match(emptyReg).Error("label cannot be empty"),
+		)),
+		v.Field(&args.Since, dateRule("since")),
+		v.Field(&args.Before, dateRule("before")),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	options := remote.SearchIssuesOptions{
+		State:       args.State,
+		Type:        args.Type,
+		Owner:       args.Owner,
+		Team:        args.Team,
+		Involvement: args.Involvement,
+		Labels:      args.Labels,
+		Milestone:   args.Milestone,
+		Query:       args.Query,
+		Limit:       args.Limit,
+		Offset:      args.Offset,
+	}
+	if options.Type == "all" {
+		options.Type = ""
+	}
+	if since, _ := parseDate(args.Since); since != nil {
+		options.Since = *since
+	}
+	if before, _ := parseDate(args.Before); before != nil {
+		options.Before = *before
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	issues, err := client.SearchIssues(ctx, options)
+	if err != nil {
+		return TextErrorf("Failed to search issues: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatIssueSearch(issues)
+	} else {
+		responseText = fmt.Sprintf("Found %d issues", len(issues))
+	}
+
+	return TextResult(responseText), &IssueList{Issues: issues}, nil
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// IssueTimelineArgs represents the arguments for listing the timeline of an issue or pull request
+type IssueTimelineArgs struct {
+	Repository  string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	IssueNumber int    `json:"issue_number"`        // Issue or pull request number
+	Limit       int    `json:"limit,omitzero"`
+	Offset      int    `json:"offset,omitzero"`
+}
+
+// IssueTimelineResult represents the result data for the issue_timeline tool
+type IssueTimelineResult struct {
+	Events []remote.TimelineEvent `json:"events"`
+	Limit  int                    `json:"limit"`
+	Offset int                    `json:"offset"`
+}
+
+// handleIssueTimeline handles the "issue_timeline" tool request.
+// It lists the comments and system events of an issue or pull request in chronological order.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number (must be positive)
+//   - limit: Maximum number of events to return (1-100, default 15)
+//   - offset: Number of events to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Events include label,
+// milestone, assignee, state, title, reference, review, and review-request changes.
+//
+// Returns:
+//   - Success: Timeline events, oldest first
+//   - Error: Validation errors or API failures
+func (s *Server) handleIssueTimeline(ctx context.Context, request *mcp.CallToolRequest, args IssueTimelineArgs) (*mcp.CallToolResult, *IssueTimelineResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.IssueNumber, v.Required.Error("issue number is required"), v.Min(1)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	events, err := client.ListIssueTimeline(ctx, repository, args.IssueNumber, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list issue timeline: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatIssueTimeline(args.IssueNumber, events)
+	} else {
+		responseText = fmt.Sprintf("Found %d timeline events for #%d", len(events), args.IssueNumber)
+	}
+
+	return TextResult(responseText), &IssueTimelineResult{
+		Events: events,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	}, nil
+}
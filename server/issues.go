@@ -18,23 +18,48 @@ type IssueList struct {
 }
 
 type IssueListArgs struct {
-	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
-	Directory  string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
-	Limit      int    `json:"limit,omitzero"`
-	Offset     int    `json:"offset,omitzero"`
+	Repository string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string   `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	State      string   `json:"state,omitzero"`      // Filter by state: "open", "closed", or "all"
+	Type       string   `json:"type,omitzero"`       // Filter by type: "issues", "pulls", or "all"
+	Labels     []string `json:"labels,omitzero"`     // Label names that must all be present
+	Milestone  string   `json:"milestone,omitzero"`  // Milestone title
+	Assignee   string   `json:"assignee,omitzero"`   // Username assigned to the issue
+	Author     string   `json:"author,omitzero"`     // Username that opened the issue
+	Mentioned  string   `json:"mentioned,omitzero"`  // Username mentioned in the issue
+	Query      string   `json:"query,omitzero"`      // Keyword searched in title and body
+	Since      string   `json:"since,omitzero"`      // Only issues updated at or after this date (YYYY-MM-DD or RFC 3339)
+	Before     string   `json:"before,omitzero"`     // Only issues updated before this date (YYYY-MM-DD or RFC 3339)
+	Sort       string   `json:"sort,omitzero"`       // Sort order, e.g. "latest", "oldest", "recentupdate", "mostcomment"
+	Limit      int      `json:"limit,omitzero"`
+	Offset     int      `json:"offset,omitzero"`
 }
 
 // handleIssueList handles the "issue_list" tool request.
-// It retrieves issues from a specified Forgejo/Gitea repository with optional pagination.
+// It retrieves issues from a specified Forgejo/Gitea repository with optional filters,
+// sorting, and pagination.
 //
 // Parameters:
 //   - repository: The repository path in "owner/repo" format
 //   - directory: Local directory path containing a git repository for automatic resolution
+//   - state: Filter by state: "open", "closed", or "all" (default "open")
+//   - type: Filter by type: "issues", "pulls", or "all" (default "all")
+//   - labels: Label names that must all be present
+//   - milestone: Milestone title
+//   - assignee: Username assigned to the issue
+//   - author: Username that opened the issue
+//   - mentioned: Username mentioned in the issue
+//   - query: Keyword searched in title and body
+//   - since: Only issues updated at or after this date (YYYY-MM-DD or RFC 3339)
+//   - before: Only issues updated before this date (YYYY-MM-DD or RFC 3339)
+//   - sort: "latest" (default), "oldest", "recentupdate", "leastupdate", "mostcomment",
+//     "leastcomment", "nearduedate", or "farduedate"
 //   - limit: Maximum number of issues to return (1-100, default 15)
 //   - offset: Number of issues to skip for pagination (default 0)
 //
 // Note: At least one of repository or directory must be provided. If both are provided,
-// directory takes precedence for automatic repository resolution.
+// directory takes precedence for automatic repository resolution. Sorting other than
+// "latest" requires a Forgejo remote.
 //
 // Migration Note: Updated to use the official SDK's handler signature and
 // result construction patterns. Error handling follows the new SDK's conventions.
@@ -67,12 +92,52 @@ func (s *Server) handleIssueList(ctx context.Context, request *mcp.CallToolReque
 				return nil
 			}),
 		)),
+		v.Field(&args.State, v.In("open", "closed", "all").Error("state must be 'open', 'closed', or 'all'")),
+		v.Field(&args.Type, v.In("issues", "pulls", "all").Error("type must be 'issues', 'pulls', or 'all'")),
+		v.Field(&args.Labels, v.Each(
+			v.Required.Error("label cannot be empty"),
+			v.Match(emptyReg).Error("label cannot be empty"),
+		)),
+		v.Field(&args.Assignee, v.Match(userReg).Error("assignee must be a valid username")),
+		v.Field(&args.Author, v.Match(userReg).Error("author must be a valid username")),
+		v.Field(&args.Mentioned, v.Match(userReg).Error("mentioned must be a valid username")),
+		v.Field(&args.Since, dateRule("since")),
+		v.Field(&args.Before, dateRule("before")),
+		v.Field(&args.Sort, v.In(
+			remote.IssueSortLatest, remote.IssueSortOldest,
+			remote.IssueSortRecentUpdate, remote.IssueSortLeastUpdate,
+			remote.IssueSortMostComment, remote.IssueSortLeastComment,
+			remote.IssueSortNearDueDate, remote.IssueSortFarDueDate,
+		).Error("sort must be one of latest, oldest, recentupdate, leastupdate, mostcomment, leastcomment, nearduedate, or farduedate")),
 		v.Field(&args.Limit, v.Min(1), v.Max(100)),
 		v.Field(&args.Offset, v.Min(0)),
 	); err != nil {
 		return TextErrorf("Invalid request: %v", err), nil, nil
 	}
 
+	options := remote.ListIssuesOptions{
+		State:     args.State,
+		Type:      args.Type,
+		Labels:    args.Labels,
+		Milestone: args.Milestone,
+		Assignee:  args.Assignee,
+		Author:    args.Author,
+		Mentioned: args.Mentioned,
+		Query:     args.Query,
+		Sort:      args.Sort,
+		Limit:     args.Limit,
+		Offset:    args.Offset,
+	}
+	if options.Type == "all" {
+		options.Type = ""
+	}
+	if since, _ := parseDate(args.Since); since != nil {
+		options.Since = *since
+	}
+	if before, _ := parseDate(args.Before); before != nil {
+		options.Before = *before
+	}
+
 	repository := args.Repository
 	if args.Directory != "" {
 		// Resolve directory to repository (takes precedence if both provided)
@@ -83,8 +148,14 @@ func (s *Server) handleIssueList(ctx context.Context, request *mcp.CallToolReque
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Fetch issues from the Gitea/Forgejo repository
-	issues, err := s.remote.ListIssues(ctx, repository, args.Limit, args.Offset)
+	issues, err := client.ListIssues(ctx, repository, options)
 	if err != nil {
 		return TextErrorf("Failed to list issues: %v", err), nil, nil
 	}
@@ -104,7 +175,8 @@ type IssueCreateArgs struct {
 	Directory   string        `json:"directory,omitzero"`
 	Title       string        `json:"title"`
 	Body        string        `json:"body,omitzero"`
-	Attachments []interface{} `json:"attachments,omitzero"` // MCP Content objects
+	Assignees   []string      `json:"assignees,omitzero"`   // Usernames to assign
+	Attachments []interface{} `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects
 }
 
 type IssueCreateResult struct {
@@ -137,6 +209,10 @@ func (s *Server) handleIssueCreate(ctx context.Context, request *mcp.CallToolReq
 		)),
 		v.Field(&args.Title, v.Required, v.Length(1, 255).Error("title must be between 1 and 255 characters")),
 		v.Field(&args.Body, v.Length(0, 65535).Error("body must be less than 65535 characters")),
+		v.Field(&args.Assignees, v.Each(
+			v.Required.Error("assignee cannot be empty"),
+			v.Match(userReg).Error("assignee must be a valid username"),
+		)),
 	); err != nil {
 		return TextErrorf("Invalid request: %v", err), nil, nil
 	}
@@ -152,46 +228,46 @@ func (s *Server) handleIssueCreate(ctx context.Context, request *mcp.CallToolReq
 	}
 
 	// Process attachments
-	var processedAttachments []remote.ProcessedAttachment
-	for _, content := range args.Attachments {
-		attachment, err := s.processAttachment(content)
-		if err != nil {
-			return TextErrorf("Invalid attachment: %v", err), nil, nil
-		}
-		processedAttachments = append(processedAttachments, *attachment)
+	processedAttachments, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
 	}
 
 	// Create issue
-	var issue *remote.Issue
-	if len(processedAttachments) > 0 {
-		// Use attachment-enabled method
-		createArgs := remote.CreateIssueWithAttachmentsArgs{
-			CreateIssueArgs: remote.CreateIssueArgs{
-				Repository: repository,
-				Title:      args.Title,
-				Body:       args.Body,
-			},
-			Attachments: processedAttachments,
-		}
+	createArgs := remote.CreateIssueArgs{
+		Repository: repository,
+		Title:      args.Title,
+		Body:       args.Body,
+		Assignees:  args.Assignees,
+	}
+	issue, err := client.CreateIssue(ctx, createArgs)
+	if err != nil {
+		return TextErrorf("Failed to create issue: %v", err), nil, nil
+	}
 
-		var err error
-		issue, err = s.remote.CreateIssueWithAttachments(ctx, createArgs)
+	// Upload attachments to the new issue and link them from its body
+	if len(processedAttachments) > 0 {
+		body, err := uploadAttachments(ctx, args.Body, processedAttachments, func(ctx context.Context, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+			return client.UploadIssueAttachment(ctx, repository, issue.Number, file)
+		})
 		if err != nil {
-			return TextErrorf("Failed to create issue with attachments: %v", err), nil, nil
+			return TextErrorf("Issue #%d was created but attaching files failed: %v", issue.Number, err), nil, nil
 		}
-	} else {
-		// Use regular method
-		createArgs := remote.CreateIssueArgs{
-			Repository: repository,
-			Title:      args.Title,
-			Body:       args.Body,
-		}
-
-		var err error
-		issue, err = s.remote.CreateIssue(ctx, createArgs)
+		edited, err := client.EditIssue(ctx, remote.EditIssueArgs{
+			Repository:  repository,
+			IssueNumber: issue.Number,
+			Body:        body,
+		})
 		if err != nil {
-			return TextErrorf("Failed to create issue: %v", err), nil, nil
+			return TextErrorf("Issue #%d was created but linking attachments failed: %v", issue.Number, err), nil, nil
 		}
+		issue = edited
 	}
 
 	// Success response
@@ -199,45 +275,18 @@ func (s *Server) handleIssueCreate(ctx context.Context, request *mcp.CallToolReq
 	return TextResult(responseText), &IssueCreateResult{Issue: issue}, nil
 }
 
-func (s *Server) processAttachment(content interface{}) (*remote.ProcessedAttachment, error) {
-	switch c := content.(type) {
-	case *mcp.ImageContent:
-		// Handle image content
-		data := []byte(c.Data) // MCP SDK handles base64 decoding
-		filename := generateFilename(c.MIMEType)
-		return &remote.ProcessedAttachment{
-			Data:     data,
-			Filename: filename,
-			MIMEType: c.MIMEType,
-		}, nil
-	default:
-		return nil, fmt.Errorf("unsupported content type: %T", content)
-	}
-}
-
-func generateFilename(mimeType string) string {
-	// Simple filename generation based on MIME type
-	// In a real implementation, this might use more sophisticated logic
-	switch mimeType {
-	case "image/jpeg":
-		return "attachment.jpg"
-	case "image/png":
-		return "attachment.png"
-	case "image/gif":
-		return "attachment.gif"
-	default:
-		return "attachment.bin"
-	}
-}
-
 // IssueEditArgs represents the arguments for editing an issue with validation tags
 type IssueEditArgs struct {
-	Repository  string `json:"repository,omitzero"` // Repository path in "owner/repo" format
-	Directory   string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
-	IssueNumber int    `json:"issue_number" validate:"required,min=1"`
-	Title       string `json:"title,omitzero"` // New title for the issue
-	Body        string `json:"body,omitzero"`  // New description/body for the issue
-	State       string `json:"state,omitzero"` // New state ("open" or "closed")
+	Repository     string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory      string   `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	IssueNumber    int      `json:"issue_number" validate:"required,min=1"`
+	Title          string   `json:"title,omitzero"`           // New title for the issue
+	Body           string   `json:"body,omitzero"`            // New description/body for the issue
+	State          string   `json:"state,omitzero"`           // New state ("open" or "closed")
+	Milestone      string   `json:"milestone,omitzero"`       // Title of the milestone to set
+	ClearMilestone bool     `json:"clear_milestone,omitzero"` // Remove the issue from its milestone
+	Assignees      []string `json:"assignees,omitzero"`       // Usernames that replace the current assignees
+	ClearAssignees bool     `json:"clear_assignees,omitzero"` // Remove all assignees from the issue
 }
 
 // IssueEditResult represents the result data for the issue_edit tool
@@ -255,10 +304,15 @@ type IssueEditResult struct {
 //   - title: New title for the issue (optional)
 //   - body: New description/body for the issue (optional)
 //   - state: New state ("open" or "closed", optional)
+//   - milestone: Title of the milestone to set (optional)
+//   - clear_milestone: Remove the issue from its milestone (optional)
+//   - assignees: Usernames that replace the current assignees (optional)
+//   - clear_assignees: Remove all assignees from the issue (optional)
 //
 // Note: At least one of repository or directory must be provided. If both are provided,
 // directory takes precedence for automatic repository resolution.
-// At least one of title, body, or state must be provided.
+// At least one change must be provided; milestone and clear_milestone are mutually
+// exclusive, as are assignees and clear_assignees.
 //
 // Returns:
 //   - Success: Issue edit confirmation with updated metadata
@@ -301,13 +355,25 @@ func (s *Server) handleIssueEdit(ctx context.Context, request *mcp.CallToolReque
 		v.Field(&args.Body, v.When(args.Body != "",
 			v.Length(1, 65535).Error("body must be between 1 and 65535 characters"),
 		)),
+		v.Field(&args.Milestone,
+			v.Match(emptyReg).Error("milestone cannot be only whitespace"),
+			v.When(args.ClearMilestone, v.Empty.Error("only one of milestone or clear_milestone may be set")),
+		),
+		v.Field(&args.Assignees,
+			v.When(args.ClearAssignees, v.Empty.Error("only one of assignees or clear_assignees may be set")),
+			v.Each(
+				v.Required.Error("assignee cannot be empty"),
+				v.Match(userReg).Error("assignee must be a valid username"),
+			),
+		),
 	); err != nil {
 		return TextErrorf("Invalid request: %v", err), nil, nil
 	}
 
 	// Ensure at least one field is being changed
-	if args.Title == "" && args.Body == "" && args.State == "" {
-		return TextError("At least one of title, body, or state must be provided"), nil, nil
+	if args.Title == "" && args.Body == "" && args.State == "" && args.Milestone == "" && !args.ClearMilestone &&
+		len(args.Assignees) == 0 && !args.ClearAssignees {
+		return TextError("At least one of title, body, state, milestone, clear_milestone, assignees, or clear_assignees must be provided"), nil, nil
 	}
 
 	repository := args.Repository
@@ -320,6 +386,12 @@ func (s *Server) handleIssueEdit(ctx context.Context, request *mcp.CallToolReque
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Edit the issue using the service layer
 	editArgs := remote.EditIssueArgs{
 		Repository:  repository,
@@ -328,7 +400,14 @@ func (s *Server) handleIssueEdit(ctx context.Context, request *mcp.CallToolReque
 		Body:        args.Body,
 		State:       args.State,
 	}
-	issue, err := s.remote.EditIssue(ctx, editArgs)
+	if args.Milestone != "" || args.ClearMilestone {
+		editArgs.Milestone = &args.Milestone
+	}
+	if len(args.Assignees) > 0 || args.ClearAssignees {
+		// A non-nil empty list clears the assignees
+		editArgs.Assignees = append([]string{}, args.Assignees...)
+	}
+	issue, err := client.EditIssue(ctx, editArgs)
 	if err != nil {
 		return TextErrorf("Failed to edit issue: %v", err), nil, nil
 	}
@@ -0,0 +1,464 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// LabelListArgs represents the arguments for listing repository labels
+type LabelListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of labels to return
+	Offset     int    `json:"offset,omitzero"`     // Number of labels to skip
+}
+
+// LabelList represents the result data for the label_list tool
+type LabelList struct {
+	Labels []remote.Label `json:"labels"`
+	Total  int            `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+// LabelCreateArgs represents the arguments for creating a repository label
+type LabelCreateArgs struct {
+	Repository  string `json:"repository,omitzero"`  // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`   // Local directory path for automatic resolution
+	Name        string `json:"name"`                 // Label name
+	Color       string `json:"color"`                // Hex color, e.g. "#ee0701"
+	Description string `json:"description,omitzero"` // Label description
+}
+
+// LabelEditArgs represents the arguments for editing a repository label.
+// Empty fields are left unchanged.
+type LabelEditArgs struct {
+	Repository  string `json:"repository,omitzero"`  // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`   // Local directory path for automatic resolution
+	Name        string `json:"name"`                 // Current label name
+	NewName     string `json:"new_name,omitzero"`    // New label name
+	Color       string `json:"color,omitzero"`       // New hex color, e.g. "#ee0701"
+	Description string `json:"description,omitzero"` // New label description
+}
+
+// LabelResult represents the result data for the label_create and label_edit tools
+type LabelResult struct {
+	Label *remote.Label `json:"label,omitempty"`
+}
+
+// IssueLabelsArgs represents the arguments for adding or removing labels on an issue or pull request
+type IssueLabelsArgs struct {
+	Repository  string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory   string   `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	IssueNumber int      `json:"issue_number"`        // Issue or pull request number
+	Labels      []string `json:"labels"`              // Label names
+}
+
+// IssueLabelsResult represents the result data for the issue_label_add and issue_label_remove tools
+type IssueLabelsResult struct {
+	Labels []remote.Label `json:"labels"` // Labels on the issue after the change
+}
+
+// handleLabelList handles the "label_list" tool request.
+// It lists the labels defined in a repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - limit: Maximum number of labels to return (1-100, default 15)
+//   - offset: Number of labels to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The repository labels with pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleLabelList(ctx context.Context, request *mcp.CallToolRequest, args LabelListArgs) (*mcp.CallToolResult, *LabelList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	labels, err := client.ListLabels(ctx, repository, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list labels: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatLabelList(labels.Labels)
+	} else {
+		responseText = fmt.Sprintf("Found %d labels", len(labels.Labels))
+	}
+
+	return TextResult(responseText), &LabelList{
+		Labels: labels.Labels,
+		Total:  labels.Total,
+		Limit:  labels.Limit,
+		Offset: labels.Offset,
+	}, nil
+}
+
+// handleLabelCreate handles the "label_create" tool request.
+// It creates a new label in a repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - name: The label name
+//   - color: The label color as a six digit hex value, with or without a leading "#"
+//   - description: The label description (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The created label
+//   - Error: Validation errors or API failures
+func (s *Server) handleLabelCreate(ctx context.Context, request *mcp.CallToolRequest, args LabelCreateArgs) (*mcp.CallToolResult, *LabelResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Name,
+			v.Required.Error("name is required"),
+			v.Match(emptyReg).Error("name cannot be only whitespace"),
+		),
+		v.Field(&args.Color,
+			v.Required.Error("color is required"),
+			v.Match(colorReg).Error("color must be a six digit hex value such as '#ee0701'"),
+		),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	label, err := client.CreateLabel(ctx, remote.CreateLabelArgs{
+		Repository:  repository,
+		Name:        args.Name,
+		Color:       args.Color,
+		Description: args.Description,
+	})
+	if err != nil {
+		return TextErrorf("Failed to create label: %v", err), nil, nil
+	}
+
+	return TextResult(fmt.Sprintf("Label created successfully: %s", label.Name)), &LabelResult{Label: label}, nil
+}
+
+// handleLabelEdit handles the "label_edit" tool request.
+// It renames a repository label or changes its color or description.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - name: The current label name
+//   - new_name: The new label name (optional)
+//   - color: The new label color as a six digit hex value (optional)
+//   - description: The new label description (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. At least one of
+// new_name, color, or description must be provided.
+//
+// Returns:
+//   - Success: The updated label
+//   - Error: Validation errors or API failures
+func (s *Server) handleLabelEdit(ctx context.Context, request *mcp.CallToolRequest, args LabelEditArgs) (*mcp.CallToolResult, *LabelResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Name,
+			v.Required.Error("name is required"),
+			v.Match(emptyReg).Error("name cannot be only whitespace"),
+		),
+		v.Field(&args.NewName,
+			v.When(args.Color == "" && args.Description == "",
+				v.Required.Error("at least one of new_name, color, or description must be provided"),
+			),
+			v.Match(emptyReg).Error("new_name cannot be only whitespace"),
+		),
+		v.Field(&args.Color, v.Match(colorReg).Error("color must be a six digit hex value such as '#ee0701'")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Only send the fields that change
+	editArgs := remote.EditLabelArgs{Repository: repository, Name: args.Name}
+	if args.NewName != "" {
+		editArgs.NewName = &args.NewName
+	}
+	if args.Color != "" {
+		editArgs.Color = &args.Color
+	}
+	if args.Description != "" {
+		editArgs.Description = &args.Description
+	}
+
+	label, err := client.EditLabel(ctx, editArgs)
+	if err != nil {
+		return TextErrorf("Failed to edit label: %v", err), nil, nil
+	}
+
+	return TextResult(fmt.Sprintf("Label edited successfully: %s", label.Name)), &LabelResult{Label: label}, nil
+}
+
+// handleIssueLabelAdd handles the "issue_label_add" tool request.
+// It adds labels, by name, to an issue or pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - labels: The label names to add
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Label names are matched
+// case-insensitively when no exact match exists.
+//
+// Returns:
+//   - Success: The labels on the issue after the change
+//   - Error: Validation errors, unknown labels, or API failures
+func (s *Server) handleIssueLabelAdd(ctx context.Context, request *mcp.CallToolRequest, args IssueLabelsArgs) (*mcp.CallToolResult, *IssueLabelsResult, error) {
+	return s.updateIssueLabels(ctx, request, args, true)
+}
+
+// handleIssueLabelRemove handles the "issue_label_remove" tool request.
+// It removes labels, by name, from an issue or pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - labels: The label names to remove
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The labels remaining on the issue
+//   - Error: Validation errors, unknown labels, or API failures
+func (s *Server) handleIssueLabelRemove(ctx context.Context, request *mcp.CallToolRequest, args IssueLabelsArgs) (*mcp.CallToolResult, *IssueLabelsResult, error) {
+	return s.updateIssueLabels(ctx, request, args, false)
+}
+
+// updateIssueLabels validates the request and adds or removes the named labels on an issue
+func (s *Server) updateIssueLabels(ctx context.Context, request *mcp.CallToolRequest, args IssueLabelsArgs, add bool) (*mcp.CallToolResult, *IssueLabelsResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.IssueNumber, v.Required.Error("issue_number is required"), v.Min(1)),
+		v.Field(&args.Labels,
+			v.Required.Error("at least one label is required"),
+			v.Each(
+				v.Required.Error("label cannot be empty"),
+				v.Match(emptyReg).Error("label cannot be only whitespace"),
+			),
+		),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	var labels []remote.Label
+	if add {
+		labels, err = client.AddIssueLabels(ctx, repository, args.IssueNumber, args.Labels)
+		if err != nil {
+			return TextErrorf("Failed to add labels: %v", err), nil, nil
+		}
+	} else {
+		labels, err = client.RemoveIssueLabels(ctx, repository, args.IssueNumber, args.Labels)
+		if err != nil {
+			return TextErrorf("Failed to remove labels: %v", err), nil, nil
+		}
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatIssueLabels(args.IssueNumber, labels)
+	} else if len(labels) == 0 {
+		responseText = fmt.Sprintf("Issue #%d has no labels", args.IssueNumber)
+	} else {
+		names := make([]string, len(labels))
+		for i, label := range labels {
+			names[i] = label.Name
+		}
+		responseText = fmt.Sprintf("Issue #%d labels: %s", args.IssueNumber, strings.Join(names, ", "))
+	}
+
+	return TextResult(responseText), &IssueLabelsResult{Labels: labels}, nil
+}
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MilestoneListArgs represents the arguments for listing repository milestones
+type MilestoneListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	State      string `json:"state,omitzero"`      // Filter by state: "open", "closed", or "all"
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of milestones to return
+	Offset     int    `json:"offset,omitzero"`     // Number of milestones to skip
+}
+
+// MilestoneList represents the result data for the milestone_list tool
+type MilestoneList struct {
+	Milestones []remote.Milestone `json:"milestones"`
+	Total      int                `json:"total"`
+	Limit      int                `json:"limit"`
+	Offset     int                `json:"offset"`
+}
+
+// MilestoneCreateArgs represents the arguments for creating a repository milestone
+type MilestoneCreateArgs struct {
+	Repository  string `json:"repository,omitzero"`  // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`   // Local directory path for automatic resolution
+	Title       string `json:"title"`                // Milestone title
+	Description string `json:"description,omitzero"` // Milestone description
+	DueDate     string `json:"due_date,omitzero"`    // Due date as YYYY-MM-DD or RFC 3339
+}
+
+// MilestoneEditArgs represents the arguments for editing a repository milestone.
+// Empty fields are left unchanged.
+type MilestoneEditArgs struct {
+	Repository  string `json:"repository,omitzero"`  // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`   // Local directory path for automatic resolution
+	Title       string `json:"title"`                // Current milestone title
+	NewTitle    string `json:"new_title,omitzero"`   // New milestone title
+	Description string `json:"description,omitzero"` // New milestone description
+	DueDate     string `json:"due_date,omitzero"`    // New due date as YYYY-MM-DD or RFC 3339
+	State       string `json:"state,omitzero"`       // New state ("open" or "closed")
+}
+
+// MilestoneResult represents the result data for the milestone_create and milestone_edit tools
+type MilestoneResult struct {
+	Milestone *remote.Milestone `json:"milestone,omitempty"`
+}
+
+// handleMilestoneList handles the "milestone_list" tool request.
+// It lists the milestones of a repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - state: Filter by state ("open", "closed", or "all", default "open")
+//   - limit: Maximum number of milestones to return (1-100, default 15)
+//   - offset: Number of milestones to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The repository milestones with pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleMilestoneList(ctx context.Context, request *mcp.CallToolRequest, args MilestoneListArgs) (*mcp.CallToolResult, *MilestoneList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set defaults if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+	if args.State == "" {
+		args.State = "open"
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.State, v.In("open", "closed", "all").Error("state must be 'open', 'closed', or 'all'")),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	milestones, err := client.ListMilestones(ctx, repository, args.State, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list milestones: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatMilestoneList(milestones.Milestones)
+	} else {
+		responseText = fmt.Sprintf("Found %d milestones", len(milestones.Milestones))
+	}
+
+	return TextResult(responseText), &MilestoneList{
+		Milestones: milestones.Milestones,
+		Total:      milestones.Total,
+		Limit:      milestones.Limit,
+		Offset:     milestones.Offset,
+	}, nil
+}
+
+// handleMilestoneCreate handles the "milestone_create" tool request.
+// It creates a new milestone in a repository.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - title: The milestone title
+//   - description: The milestone description (optional)
+//   - due_date: The due date as YYYY-MM-DD or RFC 3339 (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The created milestone
+//   - Error: Validation errors or API failures
+func (s *Server) handleMilestoneCreate(ctx context.Context, request *mcp.CallToolRequest, args MilestoneCreateArgs) (*mcp.CallToolResult, *MilestoneResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Title,
+			v.Required.Error("title is required"),
+			v.Match(emptyReg).Error("title cannot be only whitespace"),
+		),
+		v.Field(&args.DueDate, dateRule("due date")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Already validated above
+	dueDate, _ := parseDate(args.DueDate)
+
+	milestone, err := client.CreateMilestone(ctx, remote.CreateMilestoneArgs{
+		Repository:  repository,
+		Title:       args.Title,
+		Description: args.Description,
+		DueDate:     dueDate,
+	})
+	if err != nil {
+		return TextErrorf("Failed to create milestone: %v", err), nil, nil
+	}
+
+	return TextResult(fmt.Sprintf("Milestone created successfully: %s", milestone.Title)), &MilestoneResult{Milestone: milestone}, nil
+}
+
+// handleMilestoneEdit handles the "milestone_edit" tool request.
+// It changes the title, description, due date, or state of a milestone identified by title.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - title: The current milestone title
+//   - new_title: The new milestone title (optional)
+//   - description: The new milestone description (optional)
+//   - due_date: The new due date as YYYY-MM-DD or RFC 3339 (optional)
+//   - state: The new state, "open" or "closed" (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. At least one of
+// new_title, description, due_date, or state must be provided.
+//
+// Returns:
+//   - Success: The updated milestone
+//   - Error: Validation errors or API failures
+func (s *Server) handleMilestoneEdit(ctx context.Context, request *mcp.CallToolRequest, args MilestoneEditArgs) (*mcp.CallToolResult, *MilestoneResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Title,
+			v.Required.Error("title is required"),
+			v.Match(emptyReg).Error("title cannot be only whitespace"),
+		),
+		v.Field(&args.NewTitle, v.Match(emptyReg).Error("new_title cannot be only whitespace")),
+		v.Field(&args.DueDate, dateRule("due date")),
+		v.Field(&args.State, v.When(args.State != "",
+			v.In("open", "closed").Error("state must be 'open' or 'closed'"),
+		)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	// Ensure at least one field is being changed
+	if args.NewTitle == "" && args.Description == "" && args.DueDate == "" && args.State == "" {
+		return TextError("At least one of new_title, description, due_date, or state must be provided"), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Only send the fields that change
+	editArgs := remote.EditMilestoneArgs{Repository: repository, Title: args.Title}
+	if args.NewTitle != "" {
+		editArgs.NewTitle = &args.NewTitle
+	}
+	if args.Description != "" {
+		editArgs.Description = &args.Description
+	}
+	if args.State != "" {
+		editArgs.State = &args.State
+	}
+	// Already validated above
+	editArgs.DueDate, _ = parseDate(args.DueDate)
+
+	milestone, err := client.EditMilestone(ctx, editArgs)
+	if err != nil {
+		return TextErrorf("Failed to edit milestone: %v", err), nil, nil
+	}
+
+	return TextResult(fmt.Sprintf("Milestone edited successfully: %s (%s)", milestone.Title, milestone.State)), &MilestoneResult{Milestone: milestone}, nil
+}
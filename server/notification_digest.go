@@ -0,0 +1,251 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// notificationPriorities ranks notification reasons, lowest first
+var notificationPriorities = map[string]int{
+	remote.NotificationReasonReviewRequested: 1,
+	remote.NotificationReasonMention:         2,
+	remote.NotificationReasonAssigned:        3,
+	remote.NotificationReasonCI:              4,
+	remote.NotificationReasonSubscribed:      5,
+}
+
+// notificationExcerptLength is the maximum number of characters kept from the latest comment
+const notificationExcerptLength = 200
+
+// NotificationDigestArgs represents the arguments for building a notification digest
+type NotificationDigestArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format, to limit the digest to one repository
+	Directory  string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of notifications to include (1-50, default 30)
+}
+
+// NotificationDigestItem represents one unread notification in priority order
+type NotificationDigestItem struct {
+	ID                   int      `json:"id"` // Notification thread ID, for notification_mark
+	Repository           string   `json:"repository"`
+	Type                 string   `json:"type"`
+	Number               int      `json:"number,omitempty"`
+	Title                string   `json:"title"`
+	State                string   `json:"state,omitempty"`
+	HTMLURL              string   `json:"html_url,omitempty"`
+	Reason               string   `json:"reason"`  // Highest priority reason
+	Reasons              []string `json:"reasons"` // All reasons, highest priority first
+	Priority             int      `json:"priority"`
+	LatestCommentAuthor  string   `json:"latest_comment_author,omitempty"`
+	LatestCommentExcerpt string   `json:"latest_comment_excerpt,omitempty"`
+	CIState              string   `json:"ci_state,omitempty"`
+	Updated              string   `json:"updated"`
+}
+
+// NotificationDigestGroup represents the notifications of one repository that share a reason
+type NotificationDigestGroup struct {
+	Repository      string `json:"repository"`
+	Reason          string `json:"reason"`
+	Count           int    `json:"count"`
+	NotificationIDs []int  `json:"notification_ids"`
+}
+
+// NotificationDigestResult represents the result data for the notification_digest tool
+type NotificationDigestResult struct {
+	Items    []NotificationDigestItem  `json:"items"`
+	Groups   []NotificationDigestGroup `json:"groups"`
+	Total    int                       `json:"total"`    // Unread notifications before applying the limit
+	Returned int                       `json:"returned"` // Notifications included in items
+}
+
+// handleNotificationDigest handles the "notification_digest" tool request.
+// It lists unread notifications in the order they should be worked through, grouped by
+// repository and reason.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - limit: Maximum number of notifications to include (1-50, default 30)
+//
+// Note: Repository and directory are optional; without them the digest covers all
+// repositories. If both are provided, directory takes precedence for automatic repository
+// resolution. Notifications are ordered by reason (review requested, mention, assigned,
+// failing CI, subscribed) and then by most recent update. A notification is listed once,
+// under its highest priority reason. Failing CI and mentions in the latest comment are only
+// found for the notifications kept within the limit.
+//
+// Returns:
+//   - Success: The prioritized notifications with latest comment excerpts, and their groups
+//   - Error: Validation errors or API failures
+func (s *Server) handleNotificationDigest(ctx context.Context, request *mcp.CallToolRequest, args NotificationDigestArgs) (*mcp.CallToolResult, *NotificationDigestResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set defaults
+	if args.Limit == 0 {
+		args.Limit = 30
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.Match(repoReg).Error("repository must be in format 'owner/repo'")),
+		v.Field(&args.Directory, v.When(args.Directory != "",
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(50)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	details, err := client.ListUnreadNotificationDetails(ctx, repository)
+	if err != nil {
+		return TextErrorf("Failed to list notifications: %v", err), nil, nil
+	}
+
+	// Rank every unread notification first so that only the subjects of those kept are read
+	rankNotifications(details)
+	total := len(details)
+	details = details[:min(args.Limit, total)]
+	if err := client.EnrichNotificationDetails(ctx, details); err != nil {
+		return TextErrorf("Failed to list notifications: %v", err), nil, nil
+	}
+
+	result := buildNotificationDigest(details, total)
+
+	var responseText string
+	switch {
+	case s.compatMode:
+		responseText = FormatNotificationDigest(result.Items)
+	case result.Returned < result.Total:
+		responseText = fmt.Sprintf("Found %d unread notifications, showing the first %d in %d groups", result.Total, result.Returned, len(result.Groups))
+	default:
+		responseText = fmt.Sprintf("Found %d unread notifications in %d groups", result.Total, len(result.Groups))
+	}
+
+	return TextResult(responseText), result, nil
+}
+
+// buildNotificationDigest orders notifications by priority and groups them by repository and reason.
+// total is the number of unread notifications before the limit was applied.
+func buildNotificationDigest(details []remote.NotificationDetails, total int) *NotificationDigestResult {
+	rankNotifications(details)
+
+	items := make([]NotificationDigestItem, 0, len(details))
+	for _, d := range details {
+		items = append(items, NotificationDigestItem{
+			ID:                   d.ID,
+			Repository:           d.Repository,
+			Type:                 d.Type,
+			Number:               d.Number,
+			Title:                d.Title,
+			State:                d.State,
+			HTMLURL:              d.HTMLURL,
+			Reason:               d.Reasons[0],
+			Reasons:              d.Reasons,
+			Priority:             notificationPriority(d.Reasons[0]),
+			LatestCommentAuthor:  d.LatestCommentAuthor,
+			LatestCommentExcerpt: excerpt(d.LatestComment, notificationExcerptLength),
+			CIState:              d.CIState,
+			Updated:              d.Updated,
+		})
+	}
+
+	groups := []NotificationDigestGroup{}
+	index := map[[2]string]int{}
+	for _, item := range items {
+		key := [2]string{item.Repository, item.Reason}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, NotificationDigestGroup{Repository: item.Repository, Reason: item.Reason})
+		}
+		groups[i].Count++
+		groups[i].NotificationIDs = append(groups[i].NotificationIDs, item.ID)
+	}
+
+	return &NotificationDigestResult{
+		Items:    items,
+		Groups:   groups,
+		Total:    total,
+		Returned: len(items),
+	}
+}
+
+// rankNotifications sorts the reasons of each notification by priority, and the notifications by
+// their highest priority reason and then by most recent update
+func rankNotifications(details []remote.NotificationDetails) {
+	for i := range details {
+		if len(details[i].Reasons) == 0 {
+			details[i].Reasons = []string{remote.NotificationReasonSubscribed}
+		}
+		slices.SortStableFunc(details[i].Reasons, func(a, b string) int {
+			return cmp.Compare(notificationPriority(a), notificationPriority(b))
+		})
+	}
+
+	// Updated timestamps are RFC 3339 in UTC, so they sort lexically
+	slices.SortStableFunc(details, func(a, b remote.NotificationDetails) int {
+		return cmp.Or(
+			cmp.Compare(notificationPriority(a.Reasons[0]), notificationPriority(b.Reasons[0])),
+			strings.Compare(b.Updated, a.Updated),
+		)
+	})
+}
+
+// notificationPriority returns the priority of a reason, ranking unknown reasons last
+func notificationPriority(reason string) int {
+	if p, ok := notificationPriorities[reason]; ok {
+		return p
+	}
+	return len(notificationPriorities) + 1
+}
+
+// excerpt collapses whitespace in text and truncates it to at most n characters
+func excerpt(text string, n int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
@@ -90,7 +90,7 @@ func (s *Server) handleNotificationList(ctx context.Context, request *mcp.CallTo
 	}
 
 	// Get remote client
-	client, err := s.getRemoteClient()
+	client, err := s.getRemoteClient(ctx, request)
 	if err != nil {
 		return TextErrorf("Failed to get remote client: %v", err), nil, nil
 	}
@@ -111,10 +111,238 @@ func (s *Server) handleNotificationList(ctx context.Context, request *mcp.CallTo
 	}, nil
 }
 
-// getRemoteClient returns the remote client instance
-func (s *Server) getRemoteClient() (remote.ClientInterface, error) {
-	if s.remote == nil {
-		return nil, fmt.Errorf("remote client not initialized")
+// NotificationMarkArgs represents the arguments for marking notifications
+type NotificationMarkArgs struct {
+	NotificationID int    `json:"notification_id,omitzero"` // Notification thread ID
+	Repository     string `json:"repository,omitzero"`      // Repository path in "owner/repo" format, to mark all its notifications read
+	Directory      string `json:"directory,omitzero"`       // Local directory path containing a git repository for automatic resolution
+	Status         string `json:"status,omitzero"`          // New status: "read", "unread", or "pinned" (default "read")
+}
+
+// NotificationMarkResult represents the result data for the notification_mark tool
+type NotificationMarkResult struct {
+	Notifications []remote.Notification `json:"notifications"`
+	Status        string                `json:"status"`
+}
+
+// NotificationSubscriptionArgs represents the arguments for subscribing to or unsubscribing from an issue or pull request thread
+type NotificationSubscriptionArgs struct {
+	Repository  string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	IssueNumber int    `json:"issue_number"`        // Issue or pull request number
+}
+
+// NotificationSubscriptionResult represents the result data for the notification_subscribe and notification_unsubscribe tools
+type NotificationSubscriptionResult struct {
+	IssueNumber int  `json:"issue_number"`
+	Subscribed  bool `json:"subscribed"`
+}
+
+// handleNotificationMark handles the "notification_mark" tool request.
+// It marks a single notification thread as read, unread or pinned, or marks all unread
+// notifications of a repository as read.
+//
+// Parameters:
+//   - notification_id: The notification thread ID
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - status: The new status ("read", "unread", or "pinned", default "read")
+//
+// Note: Exactly one of notification_id or repository/directory must be provided. If both
+// repository and directory are provided, directory takes precedence for automatic repository
+// resolution. Marking a whole repository only supports the "read" status. Marking a pinned
+// notification as read or unread unpins it.
+//
+// Returns:
+//   - Success: The notifications that were updated
+//   - Error: Validation errors or API failures
+func (s *Server) handleNotificationMark(ctx context.Context, request *mcp.CallToolRequest, args NotificationMarkArgs) (*mcp.CallToolResult, *NotificationMarkResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set defaults
+	if args.Status == "" {
+		args.Status = remote.NotificationStatusRead
+	}
+
+	// Validate input arguments using ozzo-validation
+	byRepository := args.NotificationID == 0
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.NotificationID, v.Min(1)),
+		v.Field(&args.Repository,
+			v.When(!byRepository, v.Empty.Error("only one of notification_id or repository may be set")),
+			v.When(byRepository && args.Directory == "",
+				v.Required.Error("at least one of notification_id, directory or repository must be provided"),
+				v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+			),
+		),
+		v.Field(&args.Directory,
+			v.When(!byRepository, v.Empty.Error("only one of notification_id or directory may be set")),
+			v.When(byRepository && args.Repository == "",
+				v.Required.Error("at least one of notification_id, directory or repository must be provided"),
+				v.By(func(any) error {
+					if !filepath.IsAbs(args.Directory) {
+						return v.NewError("abs_dir", "directory must be an absolute path")
+					}
+					stat, err := os.Stat(args.Directory)
+					if err != nil {
+						return v.NewError("abs_dir", "invalid directory")
+					}
+					if !stat.IsDir() {
+						return v.NewError("abs_dir", "does not exist")
+					}
+					return nil
+				}),
+			),
+		),
+		v.Field(&args.Status,
+			v.In(remote.NotificationStatusRead, remote.NotificationStatusUnread, remote.NotificationStatusPinned).Error("status must be 'read', 'unread', or 'pinned'"),
+			v.When(byRepository, v.In(remote.NotificationStatusRead).Error("only status 'read' is supported when marking a repository")),
+		),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	if !byRepository {
+		notification, err := client.MarkNotification(ctx, args.NotificationID, args.Status)
+		if err != nil {
+			return TextErrorf("Failed to mark notification: %v", err), nil, nil
+		}
+		return TextResultf("Marked notification %d as %s", args.NotificationID, args.Status), &NotificationMarkResult{
+			Notifications: []remote.Notification{*notification},
+			Status:        args.Status,
+		}, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	notifications, err := client.MarkRepositoryNotificationsRead(ctx, repository)
+	if err != nil {
+		return TextErrorf("Failed to mark notifications: %v", err), nil, nil
+	}
+	return TextResultf("Marked %d notifications in %s as read", len(notifications), repository), &NotificationMarkResult{
+		Notifications: notifications,
+		Status:        args.Status,
+	}, nil
+}
+
+// handleNotificationSubscribe handles the "notification_subscribe" tool request.
+// It subscribes the authenticated user to the notifications of an issue or pull request thread.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Subscription confirmation
+//   - Error: Validation errors or API failures
+func (s *Server) handleNotificationSubscribe(ctx context.Context, request *mcp.CallToolRequest, args NotificationSubscriptionArgs) (*mcp.CallToolResult, *NotificationSubscriptionResult, error) {
+	return s.updateSubscription(ctx, request, args, true)
+}
+
+// handleNotificationUnsubscribe handles the "notification_unsubscribe" tool request.
+// It unsubscribes the authenticated user from the notifications of an issue or pull request thread.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Unsubscription confirmation
+//   - Error: Validation errors or API failures
+func (s *Server) handleNotificationUnsubscribe(ctx context.Context, request *mcp.CallToolRequest, args NotificationSubscriptionArgs) (*mcp.CallToolResult, *NotificationSubscriptionResult, error) {
+	return s.updateSubscription(ctx, request, args, false)
+}
+
+// updateSubscription validates the request and subscribes to or unsubscribes from an issue or pull request thread
+func (s *Server) updateSubscription(ctx context.Context, request *mcp.CallToolRequest, args NotificationSubscriptionArgs, subscribe bool) (*mcp.CallToolResult, *NotificationSubscriptionResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
 	}
-	return s.remote, nil
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.IssueNumber, v.Required.Error("issue_number is required"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	var responseText string
+	if subscribe {
+		if err := client.SubscribeIssue(ctx, repository, args.IssueNumber); err != nil {
+			return TextErrorf("Failed to subscribe: %v", err), nil, nil
+		}
+		responseText = fmt.Sprintf("Subscribed to notifications for #%d", args.IssueNumber)
+	} else {
+		if err := client.UnsubscribeIssue(ctx, repository, args.IssueNumber); err != nil {
+			return TextErrorf("Failed to unsubscribe: %v", err), nil, nil
+		}
+		responseText = fmt.Sprintf("Unsubscribed from notifications for #%d", args.IssueNumber)
+	}
+
+	return TextResult(responseText), &NotificationSubscriptionResult{
+		IssueNumber: args.IssueNumber,
+		Subscribed:  subscribe,
+	}, nil
 }
@@ -91,8 +91,14 @@ func (s *Server) handlePullRequestCommentList(ctx context.Context, request *mcp.
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Fetch pull request comments from the Gitea/Forgejo repository
-	commentList, err := s.remote.ListPullRequestComments(ctx, repository, args.PullRequestNumber, args.Limit, args.Offset)
+	commentList, err := client.ListPullRequestComments(ctx, repository, args.PullRequestNumber, args.Limit, args.Offset)
 	if err != nil {
 		return TextErrorf("Failed to list pull request comments: %v", err), nil, nil
 	}
@@ -109,6 +115,9 @@ func (s *Server) handlePullRequestCommentList(ctx context.Context, request *mcp.
 				endIndex)
 			for i, comment := range commentList.Comments {
 				responseText += fmt.Sprintf("Comment %d (ID: %d): %s\n", i+1, comment.ID, comment.Content)
+				if len(comment.Reactions) > 0 {
+					responseText += fmt.Sprintf("  Reactions: %s\n", FormatReactionSummary(comment.Reactions))
+				}
 			}
 		}
 	} else {
@@ -124,6 +133,7 @@ type PullRequestCommentCreateArgs struct {
 	Directory         string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
 	PullRequestNumber int    `json:"pull_request_number" validate:"required,min=1"`
 	Comment           string `json:"comment" validate:"required,min=1"`
+	Attachments       []any  `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects
 }
 
 // PullRequestCommentCreateResult represents the result data for the pr_comment_create tool
@@ -139,9 +149,11 @@ type PullRequestCommentCreateResult struct {
 //   - directory: Local directory path containing a git repository for automatic resolution
 //   - pull_request_number: The pull request number to comment on (must be positive)
 //   - comment: The comment content (cannot be empty)
+//   - attachments: MCP image, audio, or embedded resource content objects to attach (optional)
 //
 // Note: At least one of repository or directory must be provided. If both are provided,
-// directory takes precedence for automatic repository resolution.
+// directory takes precedence for automatic repository resolution. Attachments require
+// attachment.enabled in the server configuration and are linked at the end of the comment.
 //
 // Returns:
 //   - Success: Comment creation confirmation with metadata
@@ -194,12 +206,44 @@ func (s *Server) handlePullRequestCommentCreate(ctx context.Context, request *mc
 		repository = resolution.Repository
 	}
 
+	// Process attachments
+	files, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Create the comment using the service layer
-	comment, err := s.remote.CreatePullRequestComment(ctx, repository, args.PullRequestNumber, args.Comment)
+	comment, err := client.CreatePullRequestComment(ctx, repository, args.PullRequestNumber, args.Comment)
 	if err != nil {
 		return TextErrorf("Failed to create pull request comment: %v", err), nil, nil
 	}
 
+	// Upload attachments to the new comment and link them from its body
+	if len(files) > 0 {
+		content, err := uploadAttachments(ctx, comment.Content, files, func(ctx context.Context, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+			return client.UploadCommentAttachment(ctx, repository, comment.ID, file)
+		})
+		if err != nil {
+			return TextErrorf("Comment %d was created but attaching files failed: %v", comment.ID, err), nil, nil
+		}
+		edited, err := client.EditPullRequestComment(ctx, remote.EditPullRequestCommentArgs{
+			Repository:        repository,
+			PullRequestNumber: args.PullRequestNumber,
+			CommentID:         comment.ID,
+			NewContent:        content,
+		})
+		if err != nil {
+			return TextErrorf("Comment %d was created but linking attachments failed: %v", comment.ID, err), nil, nil
+		}
+		comment = edited
+	}
+
 	var responseText string
 	if s.compatMode {
 		responseText = fmt.Sprintf("Pull request comment created successfully. ID: %d, Created: %s\nComment body: %s",
@@ -290,6 +334,12 @@ func (s *Server) handlePullRequestCommentEdit(ctx context.Context, request *mcp.
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Edit the comment using the service layer
 	editArgs := remote.EditPullRequestCommentArgs{
 		Repository:        repository,
@@ -297,7 +347,7 @@ func (s *Server) handlePullRequestCommentEdit(ctx context.Context, request *mcp.
 		CommentID:         args.CommentID,
 		NewContent:        args.NewContent,
 	}
-	comment, err := s.remote.EditPullRequestComment(ctx, editArgs)
+	comment, err := client.EditPullRequestComment(ctx, editArgs)
 	if err != nil {
 		return TextErrorf("Failed to edit pull request comment: %v", err), nil, nil
 	}
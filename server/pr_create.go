@@ -14,14 +14,17 @@ import (
 
 // PullRequestCreateArgs represents the arguments for creating a pull request with validation tags
 type PullRequestCreateArgs struct {
-	Repository string `json:"repository,omitzero"`       // Repository path in "owner/repo" format
-	Directory  string `json:"directory,omitzero"`        // Local directory path containing a git repository for automatic resolution
-	Head       string `json:"head,omitzero"`             // Source branch (auto-detected if not provided)
-	Base       string `json:"base,omitzero"`             // Target branch (default if not provided)
-	Title      string `json:"title" validate:"required"` // PR title
-	Body       string `json:"body,omitzero"`             // PR description
-	Draft      bool   `json:"draft,omitzero"`            // Create as draft PR
-	Assignee   string `json:"assignee,omitzero"`         // Single reviewer
+	Repository string   `json:"repository,omitzero"`       // Repository path in "owner/repo" format
+	Directory  string   `json:"directory,omitzero"`        // Local directory path containing a git repository for automatic resolution
+	Head       string   `json:"head,omitzero"`             // Source branch (auto-detected if not provided)
+	Base       string   `json:"base,omitzero"`             // Target branch (default if not provided)
+	Title      string   `json:"title" validate:"required"` // PR title
+	Body       string   `json:"body,omitzero"`             // PR description
+	Draft      bool     `json:"draft,omitzero"`            // Create as draft PR
+	Assignee   string   `json:"assignee,omitzero"`         // Single assignee, kept for compatibility with assignees
+	Assignees  []string `json:"assignees,omitzero"`        // Usernames to assign
+
+	Attachments []any `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects
 }
 
 // PullRequestCreateResult represents the result data for the pr_create tool
@@ -40,10 +43,13 @@ type PullRequestCreateResult struct {
 //   - title: PR title (required)
 //   - body: PR description (optional)
 //   - draft: Create as draft PR (optional)
-//   - assignee: Single reviewer (optional)
+//   - assignee: Single assignee, merged into assignees (optional)
+//   - assignees: Usernames to assign (optional)
+//   - attachments: MCP image, audio, or embedded resource content objects to attach (optional)
 //
 // Note: At least one of repository or directory must be provided. If both are provided,
-// directory takes precedence for automatic repository resolution.
+// directory takes precedence for automatic repository resolution. Attachments require
+// attachment.enabled in the server configuration and are linked at the end of the body.
 //
 // Returns:
 //   - Success: Pull request creation confirmation with metadata
@@ -93,10 +99,20 @@ func (s *Server) handlePullRequestCreate(ctx context.Context, request *mcp.CallT
 		v.Field(&args.Assignee, v.When(args.Assignee != "",
 			v.Length(1, 255).Error("assignee must be between 1 and 255 characters"),
 		)),
+		v.Field(&args.Assignees, v.Each(
+			v.Required.Error("assignee cannot be empty"),
+			v.Match(userReg).Error("assignee must be a valid username"),
+		)),
 	); err != nil {
 		return TextErrorf("Invalid request: %v", err), nil, nil
 	}
 
+	// Process attachments
+	files, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
 	repository := args.Repository
 	var forkInfo *ForkInfo
 	if args.Directory != "" {
@@ -182,11 +198,17 @@ func (s *Server) handlePullRequestCreate(ctx context.Context, request *mcp.CallT
 		}
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Load PR template if no body is provided
 	body := args.Body
 	if body == "" && args.Directory != "" {
 		// Try to load template from the repository
-		if fileContentFetcher, ok := s.remote.(remote.FileContentFetcher); ok {
+		if fileContentFetcher, ok := client.(remote.FileContentFetcher); ok {
 			owner, repoName, ok := strings.Cut(repository, "/")
 			if ok {
 				template, err := LoadPRTemplate(ctx, fileContentFetcher, owner, repoName, base)
@@ -211,12 +233,32 @@ func (s *Server) handlePullRequestCreate(ctx context.Context, request *mcp.CallT
 		Body:       body,
 		Draft:      args.Draft,
 		Assignee:   args.Assignee,
+		Assignees:  args.Assignees,
 	}
-	pr, err := s.remote.CreatePullRequest(ctx, createArgs)
+	pr, err := client.CreatePullRequest(ctx, createArgs)
 	if err != nil {
 		return enhancePullRequestCreationError(err, repository, head, base), nil, nil
 	}
 
+	// Upload attachments to the new pull request and link them from its body
+	if len(files) > 0 {
+		prBody, err := uploadAttachments(ctx, pr.Body, files, func(ctx context.Context, file remote.ProcessedAttachment) (*remote.Attachment, error) {
+			return client.UploadIssueAttachment(ctx, repository, pr.Number, file)
+		})
+		if err != nil {
+			return TextErrorf("Pull request #%d was created but attaching files failed: %v", pr.Number, err), nil, nil
+		}
+		edited, err := client.EditPullRequest(ctx, remote.EditPullRequestArgs{
+			Repository:        repository,
+			PullRequestNumber: pr.Number,
+			Body:              prBody,
+		})
+		if err != nil {
+			return TextErrorf("Pull request #%d was created but linking attachments failed: %v", pr.Number, err), nil, nil
+		}
+		pr = edited
+	}
+
 	var responseText string
 	if s.compatMode {
 		responseText = fmt.Sprintf("Pull request created successfully. Number: %d, Title: %s, State: %s",
@@ -317,6 +359,11 @@ func enhancePullRequestCreationError(err error, repository, head, base string) *
 
 	errMsg := err.Error()
 
+	// Assignee validation errors are already descriptive
+	if strings.Contains(errMsg, "users not found") || strings.Contains(errMsg, "users without") {
+		return TextErrorf("%s: %v", baseMsg, err)
+	}
+
 	// API-related errors
 	if strings.Contains(errMsg, "401") || strings.Contains(errMsg, "unauthorized") {
 		return TextErrorf("%s: Authentication failed. Please check your API token is valid and has pull request permissions.", baseMsg)
@@ -0,0 +1,412 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultDiffMaxLinesPerFile caps each file section of pr_diff output unless overridden
+const defaultDiffMaxLinesPerFile = 500
+
+// PullRequestDiffArgs represents the arguments for fetching a pull request diff
+type PullRequestDiffArgs struct {
+	Repository        string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string   `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int      `json:"pull_request_number"`
+	Paths             []string `json:"paths,omitzero"`              // Limit the diff to these files, directories, or glob patterns
+	Limit             int      `json:"limit,omitzero"`              // Maximum number of files to include
+	Offset            int      `json:"offset,omitzero"`             // Number of files to skip
+	MaxLinesPerFile   int      `json:"max_lines_per_file,omitzero"` // Truncate each file's diff after this many lines
+}
+
+// DiffFileSummary describes one file section included in a pr_diff result
+type DiffFileSummary struct {
+	Path      string `json:"path"`
+	Lines     int    `json:"lines"`     // Number of diff lines for the file before truncation
+	Truncated bool   `json:"truncated"` // Whether the file's diff was cut at max_lines_per_file
+}
+
+// PullRequestDiffResult represents the result data for the pr_diff tool
+type PullRequestDiffResult struct {
+	Diff   string            `json:"diff"`
+	Files  []DiffFileSummary `json:"files"`
+	Total  int               `json:"total"` // Number of files matching the path filter
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+}
+
+// PullRequestFilesArgs represents the arguments for listing the files changed by a pull request
+type PullRequestFilesArgs struct {
+	Repository        string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int    `json:"pull_request_number"`
+	Limit             int    `json:"limit,omitzero"`
+	Offset            int    `json:"offset,omitzero"`
+}
+
+// PullRequestFileList represents the result data for the pr_files tool
+type PullRequestFileList struct {
+	Files  []remote.ChangedFile `json:"files"`
+	Total  int                  `json:"total"`
+	Limit  int                  `json:"limit"`
+	Offset int                  `json:"offset"`
+}
+
+// fileDiff is the section of a unified diff belonging to a single file
+type fileDiff struct {
+	path string
+	text string
+}
+
+// splitDiff splits a unified diff into per-file sections on "diff --git" headers.
+// Sections are slices of diff between consecutive header offsets, so large diffs
+// are split without copying.
+func splitDiff(diff string) []fileDiff {
+	var files []fileDiff
+	start := -1
+	for offset := 0; offset < len(diff); {
+		line, _, _ := strings.Cut(diff[offset:], "\n")
+		if strings.HasPrefix(line, "diff --git ") {
+			// Anything before the first header is not part of a file section
+			if start >= 0 {
+				files[len(files)-1].text = diff[start:offset]
+			}
+			files = append(files, fileDiff{})
+			start = offset
+		}
+		offset += len(line) + 1
+	}
+	if start >= 0 {
+		files[len(files)-1].text = diff[start:]
+	}
+	for i := range files {
+		files[i].path = diffPath(files[i].text)
+	}
+	return files
+}
+
+// diffPath extracts the new file path of a file section. The path is read from the
+// "+++ b/" or "rename to" line, falling back to "--- a/" for deleted files and to the
+// "diff --git" header for sections without either, such as binary files or mode changes.
+func diffPath(section string) string {
+	header, rest, _ := strings.Cut(section, "\n")
+	var oldPath string
+	for rest != "" {
+		var line string
+		line, rest, _ = strings.Cut(rest, "\n")
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			rest = ""
+		case strings.HasPrefix(line, "rename to "):
+			return unquoteDiffPath(strings.TrimPrefix(line, "rename to "), "")
+		case strings.HasPrefix(line, "+++ "):
+			if name := strings.TrimPrefix(line, "+++ "); name != "/dev/null" {
+				return unquoteDiffPath(name, "b/")
+			}
+		case strings.HasPrefix(line, "--- "):
+			if name := strings.TrimPrefix(line, "--- "); name != "/dev/null" {
+				oldPath = unquoteDiffPath(name, "a/")
+			}
+		}
+	}
+	if oldPath != "" {
+		return oldPath
+	}
+	return headerPath(strings.TrimPrefix(header, "diff --git "))
+}
+
+// headerPath extracts the file path from the "a/<old> b/<new>" part of a "diff --git" header.
+// Unquoted headers are ambiguous when a path contains " b/", so the path is only split
+// in the middle when both sides name the same file.
+func headerPath(header string) string {
+	if i := strings.LastIndex(header, ` "b/`); i >= 0 && strings.HasSuffix(header, `"`) {
+		return unquoteDiffPath(header[i+1:], "b/")
+	}
+	if n := len(header) - len("a/ b/"); n > 0 && n%2 == 0 {
+		if old, name := header[len("a/"):len("a/")+n/2], header[len(header)-n/2:]; old == name {
+			return name
+		}
+	}
+	if i := strings.LastIndex(header, " b/"); i >= 0 {
+		return header[i+len(" b/"):]
+	}
+	return header
+}
+
+// unquoteDiffPath decodes a path as git writes it in diff headers, unquoting C-style
+// quoted names and removing the "a/" or "b/" prefix
+func unquoteDiffPath(name, prefix string) string {
+	// Git appends a tab to names containing spaces in the ---/+++ lines
+	name = strings.TrimSuffix(name, "\t")
+	if strings.HasPrefix(name, `"`) {
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			name = unquoted
+		}
+	}
+	return strings.TrimPrefix(name, prefix)
+}
+
+// matchDiffPath reports whether file matches one of the requested paths.
+// A pattern matches the exact file, any file below it as a directory, or as a glob.
+func matchDiffPath(file string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(pattern, "/")
+		if file == pattern || strings.HasPrefix(file, pattern+"/") {
+			return true
+		}
+		if ok, _ := path.Match(pattern, file); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// truncateFileDiff cuts a file section after maxLines lines and appends a marker naming what was dropped.
+// It returns the resulting text, the original line count, and whether truncation happened.
+func truncateFileDiff(file fileDiff, maxLines int) (string, int, bool) {
+	lines := strings.Split(strings.TrimSuffix(file.text, "\n"), "\n")
+	if len(lines) <= maxLines {
+		return file.text, len(lines), false
+	}
+	kept := strings.Join(lines[:maxLines], "\n")
+	return fmt.Sprintf("%s\n... [truncated %d more lines of %s] ...\n", kept, len(lines)-maxLines, file.path), len(lines), true
+}
+
+// handlePullRequestDiff handles the "pr_diff" tool request.
+// It fetches the unified diff of a pull request, optionally filtered to specific paths,
+// and paginates it by file with per-file truncation so large diffs fit a model context.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number (must be positive)
+//   - paths: Files, directories, or glob patterns to include (optional, defaults to all files)
+//   - limit: Maximum number of files to include (1-100, default 15)
+//   - offset: Number of files to skip for pagination (default 0)
+//   - max_lines_per_file: Maximum diff lines per file before truncation (1-10000, default 500)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The diff for the selected files with a summary of each file section
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestDiff(ctx context.Context, request *mcp.CallToolRequest, args PullRequestDiffArgs) (*mcp.CallToolResult, *PullRequestDiffResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set defaults if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+	if args.MaxLinesPerFile == 0 {
+		args.MaxLinesPerFile = defaultDiffMaxLinesPerFile
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.Paths, v.Each(
+			v.Required.Error("path cannot be empty"),
+			v.By(func(value any) error {
+				if _, err := path.Match(value.(string), ""); err != nil {
+					return v.NewError("path_pattern", "invalid path pattern")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+		v.Field(&args.MaxLinesPerFile, v.Min(1), v.Max(10000)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Fetch the full diff
+	diff, err := client.GetPullRequestDiff(ctx, repository, args.PullRequestNumber)
+	if err != nil {
+		return TextErrorf("Failed to get pull request diff: %v", err), nil, nil
+	}
+
+	var matched []fileDiff
+	for _, file := range splitDiff(diff) {
+		if matchDiffPath(file.path, args.Paths) {
+			matched = append(matched, file)
+		}
+	}
+
+	// Paginate by file
+	start := min(args.Offset, len(matched))
+	end := min(start+args.Limit, len(matched))
+
+	var builder strings.Builder
+	files := make([]DiffFileSummary, 0, end-start)
+	for _, file := range matched[start:end] {
+		text, lines, truncated := truncateFileDiff(file, args.MaxLinesPerFile)
+		builder.WriteString(text)
+		files = append(files, DiffFileSummary{Path: file.path, Lines: lines, Truncated: truncated})
+	}
+	if remaining := len(matched) - end; remaining > 0 {
+		fmt.Fprintf(&builder, "... [%d more files not shown, use offset %d to continue] ...\n", remaining, end)
+	}
+
+	result := &PullRequestDiffResult{
+		Diff:   builder.String(),
+		Files:  files,
+		Total:  len(matched),
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatPullRequestDiff(args.PullRequestNumber, result)
+	} else {
+		responseText = fmt.Sprintf("Diff of %d of %d files in pull request #%d", len(files), len(matched), args.PullRequestNumber)
+	}
+
+	return TextResult(responseText), result, nil
+}
+
+// handlePullRequestFiles handles the "pr_files" tool request.
+// It lists the files changed by a pull request with per-file line statistics.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number (must be positive)
+//   - limit: Maximum number of files to return (1-100, default 15)
+//   - offset: Number of files to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Changed files with status, additions, deletions, and previous name for renames
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestFiles(ctx context.Context, request *mcp.CallToolRequest, args PullRequestFilesArgs) (*mcp.CallToolResult, *PullRequestFileList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// List changed files
+	fileList, err := client.ListPullRequestFiles(ctx, repository, args.PullRequestNumber, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list pull request files: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatPullRequestFileList(fileList.Files)
+	} else {
+		responseText = fmt.Sprintf("Found %d changed files", len(fileList.Files))
+	}
+
+	return TextResult(responseText), &PullRequestFileList{
+		Files:  fileList.Files,
+		Total:  fileList.Total,
+		Limit:  fileList.Limit,
+		Offset: fileList.Offset,
+	}, nil
+}
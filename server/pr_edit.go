@@ -13,13 +13,17 @@ import (
 
 // PullRequestEditArgs represents the arguments for editing a pull request with validation tags
 type PullRequestEditArgs struct {
-	Repository        string `json:"repository,omitzero"` // Repository path in "owner/repo" format
-	Directory         string `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
-	PullRequestNumber int    `json:"pull_request_number" validate:"required,min=1"`
-	Title             string `json:"title,omitzero"`       // New title for the pull request
-	Body              string `json:"body,omitzero"`        // New description/body for the pull request
-	State             string `json:"state,omitzero"`       // New state ("open" or "closed")
-	BaseBranch        string `json:"base_branch,omitzero"` // New base branch for the pull request
+	Repository        string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string   `json:"directory,omitzero"`  // Local directory path containing a git repository for automatic resolution
+	PullRequestNumber int      `json:"pull_request_number" validate:"required,min=1"`
+	Title             string   `json:"title,omitzero"`           // New title for the pull request
+	Body              string   `json:"body,omitzero"`            // New description/body for the pull request
+	State             string   `json:"state,omitzero"`           // New state ("open" or "closed")
+	BaseBranch        string   `json:"base_branch,omitzero"`     // New base branch for the pull request
+	Milestone         string   `json:"milestone,omitzero"`       // Title of the milestone to set
+	ClearMilestone    bool     `json:"clear_milestone,omitzero"` // Remove the pull request from its milestone
+	Assignees         []string `json:"assignees,omitzero"`       // Usernames that replace the current assignees
+	ClearAssignees    bool     `json:"clear_assignees,omitzero"` // Remove all assignees from the pull request
 }
 
 // PullRequestEditResult represents the result data for the pr_edit tool
@@ -38,10 +42,15 @@ type PullRequestEditResult struct {
 //   - body: New description/body for the pull request (optional)
 //   - state: New state ("open" or "closed", optional)
 //   - base_branch: New base branch for the pull request (optional)
+//   - milestone: Title of the milestone to set (optional)
+//   - clear_milestone: Remove the pull request from its milestone (optional)
+//   - assignees: Usernames that replace the current assignees (optional)
+//   - clear_assignees: Remove all assignees from the pull request (optional)
 //
 // Note: At least one of repository or directory must be provided. If both are provided,
 // directory takes precedence for automatic repository resolution.
-// At least one of title, body, state, or base_branch must be provided.
+// At least one change must be provided; milestone and clear_milestone are mutually
+// exclusive, as are assignees and clear_assignees.
 //
 // Returns:
 //   - Success: Pull request edit confirmation with updated metadata
@@ -91,13 +100,25 @@ func (s *Server) handlePullRequestEdit(ctx context.Context, request *mcp.CallToo
 		v.Field(&args.BaseBranch, v.When(args.BaseBranch != "",
 			v.Length(1, 255).Error("base branch must be between 1 and 255 characters"),
 		)),
+		v.Field(&args.Milestone,
+			v.Match(emptyReg).Error("milestone cannot be only whitespace"),
+			v.When(args.ClearMilestone, v.Empty.Error("only one of milestone or clear_milestone may be set")),
+		),
+		v.Field(&args.Assignees,
+			v.When(args.ClearAssignees, v.Empty.Error("only one of assignees or clear_assignees may be set")),
+			v.Each(
+				v.Required.Error("assignee cannot be empty"),
+				v.Match(userReg).Error("assignee must be a valid username"),
+			),
+		),
 	); err != nil {
 		return TextErrorf("Invalid request: %v", err), nil, nil
 	}
 
 	// Ensure at least one field is being changed
-	if args.Title == "" && args.Body == "" && args.State == "" && args.BaseBranch == "" {
-		return TextError("At least one of title, body, state, or base_branch must be provided"), nil, nil
+	if args.Title == "" && args.Body == "" && args.State == "" && args.BaseBranch == "" && args.Milestone == "" && !args.ClearMilestone &&
+		len(args.Assignees) == 0 && !args.ClearAssignees {
+		return TextError("At least one of title, body, state, base_branch, milestone, clear_milestone, assignees, or clear_assignees must be provided"), nil, nil
 	}
 
 	repository := args.Repository
@@ -110,6 +131,12 @@ func (s *Server) handlePullRequestEdit(ctx context.Context, request *mcp.CallToo
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Edit the pull request using the service layer
 	editArgs := remote.EditPullRequestArgs{
 		Repository:        repository,
@@ -119,7 +146,14 @@ func (s *Server) handlePullRequestEdit(ctx context.Context, request *mcp.CallToo
 		State:             args.State,
 		BaseBranch:        args.BaseBranch,
 	}
-	pr, err := s.remote.EditPullRequest(ctx, editArgs)
+	if args.Milestone != "" || args.ClearMilestone {
+		editArgs.Milestone = &args.Milestone
+	}
+	if len(args.Assignees) > 0 || args.ClearAssignees {
+		// A non-nil empty list clears the assignees
+		editArgs.Assignees = append([]string{}, args.Assignees...)
+	}
+	pr, err := client.EditPullRequest(ctx, editArgs)
 	if err != nil {
 		return TextErrorf("Failed to edit pull request: %v", err), nil, nil
 	}
@@ -21,6 +21,7 @@ type PullRequestFetchArgs struct {
 // PullRequestFetchResult represents the result data for the pr_fetch tool
 type PullRequestFetchResult struct {
 	PullRequest *remote.PullRequestDetails `json:"pull_request,omitempty"`
+	Checks      *CheckSummary              `json:"checks,omitempty"` // CI state of the head commit, omitted when unavailable
 }
 
 // handlePullRequestFetch handles the "pr_fetch" tool request.
@@ -35,7 +36,7 @@ type PullRequestFetchResult struct {
 // directory takes precedence for automatic repository resolution.
 //
 // Returns:
-//   - Success: Detailed pull request information including metadata, labels, assignees, etc.
+//   - Success: Detailed pull request information including metadata, labels, assignees, and CI state
 //   - Error: Validation errors or API failures
 func (s *Server) handlePullRequestFetch(ctx context.Context, request *mcp.CallToolRequest, args PullRequestFetchArgs) (*mcp.CallToolResult, *PullRequestFetchResult, error) {
 	// Validate context
@@ -80,18 +81,38 @@ func (s *Server) handlePullRequestFetch(ctx context.Context, request *mcp.CallTo
 		repository = resolution.Repository
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Fetch the pull request
-	pr, err := s.remote.GetPullRequest(ctx, repository, args.PullRequestNumber)
+	pr, err := client.GetPullRequest(ctx, repository, args.PullRequestNumber)
 	if err != nil {
 		return TextErrorf("Failed to fetch pull request: %v", err), nil, nil
 	}
 
+	// Summarize CI checks of the head commit; a missing status must not fail the fetch
+	var checks *CheckSummary
+	if pr.Head.Sha != "" {
+		if status, err := client.GetCombinedCommitStatus(ctx, repository, pr.Head.Sha); err == nil {
+			checks = summarizeCommitStatus(status)
+		}
+	}
+
 	var responseText string
 	if s.compatMode {
 		responseText = FormatPullRequestDetails(pr)
+		if checks != nil {
+			responseText += FormatCheckSummary(checks)
+		}
 	} else {
 		responseText = fmt.Sprintf("Pull request #%d: %s", pr.Number, pr.Title)
+		if checks != nil {
+			responseText += fmt.Sprintf(" (CI: %s)", checks.State)
+		}
 	}
 
-	return TextResult(responseText), &PullRequestFetchResult{PullRequest: pr}, nil
+	return TextResult(responseText), &PullRequestFetchResult{PullRequest: pr, Checks: checks}, nil
 }
@@ -97,8 +97,14 @@ func (s *Server) handlePullRequestList(ctx context.Context, request *mcp.CallToo
 		Offset: args.Offset,
 	}
 
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
 	// Fetch pull requests from the Gitea/Forgejo repository
-	pullRequests, err := s.remote.ListPullRequests(ctx, repository, options)
+	pullRequests, err := client.ListPullRequests(ctx, repository, options)
 	if err != nil {
 		return TextErrorf("Failed to list pull requests: %v", err), nil, nil
 	}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// PullRequestMergeArgs represents the arguments for merging a pull request
+type PullRequestMergeArgs struct {
+	Repository             string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory              string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber      int    `json:"pull_request_number" validate:"required,min=1"`
+	Style                  string `json:"style,omitzero"`                     // "merge", "rebase", "rebase-merge", "squash", or "fast-forward-only" (default "merge")
+	Title                  string `json:"title,omitzero"`                     // Custom merge commit title
+	Message                string `json:"message,omitzero"`                   // Custom merge commit message
+	DeleteBranch           bool   `json:"delete_branch,omitzero"`             // Delete the head branch after merging
+	MergeWhenChecksSucceed bool   `json:"merge_when_checks_succeed,omitzero"` // Schedule the merge until all status checks pass
+}
+
+// PullRequestMergeResult represents the result data for the pr_merge tool
+type PullRequestMergeResult struct {
+	Merge *remote.MergeResult `json:"merge,omitempty"`
+}
+
+// handlePullRequestMerge handles the "pr_merge" tool request.
+// It merges a pull request in a Forgejo/Gitea repository, or schedules the
+// merge to happen once all status checks succeed.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number to merge (must be positive)
+//   - style: Merge style ("merge", "rebase", "rebase-merge", "squash", "fast-forward-only"; optional)
+//   - title: Custom merge commit title (optional, not used by rebase or fast-forward-only)
+//   - message: Custom merge commit message (optional, not used by rebase or fast-forward-only)
+//   - delete_branch: Delete the head branch after merging (optional)
+//   - merge_when_checks_succeed: Wait for status checks before merging (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Whether the pull request was merged or scheduled, and the style used
+//   - Error: Validation errors, unmergeable pull requests, or API failures
+func (s *Server) handlePullRequestMerge(ctx context.Context, request *mcp.CallToolRequest, args PullRequestMergeArgs) (*mcp.CallToolResult, *PullRequestMergeResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Rebase and fast-forward merges replay existing commits, so there is no commit message to set
+	noMergeCommit := args.Style == remote.MergeStyleRebase || args.Style == remote.MergeStyleFastForwardOnly
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.Style, v.When(args.Style != "",
+			v.In(remote.MergeStyleMerge, remote.MergeStyleRebase, remote.MergeStyleRebaseMerge,
+				remote.MergeStyleSquash, remote.MergeStyleFastForwardOnly,
+			).Error("style must be 'merge', 'rebase', 'rebase-merge', 'squash', or 'fast-forward-only'"),
+		)),
+		v.Field(&args.Title,
+			v.When(noMergeCommit, v.Empty.Error("title is not supported with "+args.Style+" merges")),
+			v.Length(0, 255).Error("title must be at most 255 characters"),
+		),
+		v.Field(&args.Message,
+			v.When(noMergeCommit, v.Empty.Error("message is not supported with "+args.Style+" merges")),
+		),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Merge the pull request
+	result, err := client.MergePullRequest(ctx, remote.MergePullRequestArgs{
+		Repository:             repository,
+		PullRequestNumber:      args.PullRequestNumber,
+		Style:                  args.Style,
+		Title:                  args.Title,
+		Message:                args.Message,
+		DeleteBranchAfterMerge: args.DeleteBranch,
+		MergeWhenChecksSucceed: args.MergeWhenChecksSucceed,
+	})
+	if err != nil {
+		return TextErrorf("Failed to merge pull request: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatPullRequestMergeSuccess(args.PullRequestNumber, result, args.DeleteBranch)
+	} else if result.Scheduled {
+		responseText = fmt.Sprintf("Pull request #%d scheduled to merge", args.PullRequestNumber)
+	} else {
+		responseText = fmt.Sprintf("Pull request #%d merged", args.PullRequestNumber)
+	}
+
+	return TextResult(responseText), &PullRequestMergeResult{Merge: result}, nil
+}
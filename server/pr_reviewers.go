@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// PullRequestReviewersArgs represents the arguments for requesting or removing pull request reviewers
+type PullRequestReviewersArgs struct {
+	Repository        string   `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string   `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int      `json:"pull_request_number"`
+	Reviewers         []string `json:"reviewers,omitzero"`      // Usernames
+	TeamReviewers     []string `json:"team_reviewers,omitzero"` // Organization team names
+}
+
+// PullRequestReviewersResult represents the result data for the pr_reviewer_request and pr_reviewer_remove tools
+type PullRequestReviewersResult struct {
+	PullRequestNumber int      `json:"pull_request_number"`
+	Reviewers         []string `json:"reviewers,omitempty"`
+	TeamReviewers     []string `json:"team_reviewers,omitempty"`
+}
+
+// handlePullRequestReviewerRequest handles the "pr_reviewer_request" tool request.
+// It requests reviews on a pull request from users and teams.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number
+//   - reviewers: Usernames to request a review from
+//   - team_reviewers: Organization team names to request a review from
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. At least one reviewer or
+// team reviewer is required, and user reviewers must exist and have access to the
+// repository.
+//
+// Returns:
+//   - Success: The reviewers that were requested
+//   - Error: Validation errors, unknown users, or API failures
+func (s *Server) handlePullRequestReviewerRequest(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewersArgs) (*mcp.CallToolResult, *PullRequestReviewersResult, error) {
+	return s.updatePullRequestReviewers(ctx, request, args, true)
+}
+
+// handlePullRequestReviewerRemove handles the "pr_reviewer_remove" tool request.
+// It removes pending review requests for users and teams from a pull request.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number
+//   - reviewers: Usernames whose review request is removed
+//   - team_reviewers: Organization team names whose review request is removed
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. At least one reviewer or
+// team reviewer is required.
+//
+// Returns:
+//   - Success: The reviewers whose requests were removed
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestReviewerRemove(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewersArgs) (*mcp.CallToolResult, *PullRequestReviewersResult, error) {
+	return s.updatePullRequestReviewers(ctx, request, args, false)
+}
+
+// updatePullRequestReviewers validates the request and adds or removes review requests on a pull request
+func (s *Server) updatePullRequestReviewers(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewersArgs, add bool) (*mcp.CallToolResult, *PullRequestReviewersResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull_request_number is required"), v.Min(1)),
+		v.Field(&args.Reviewers,
+			v.When(len(args.TeamReviewers) == 0,
+				v.Required.Error("at least one of reviewers or team_reviewers must be provided"),
+			),
+			v.Each(
+				v.Required.Error("reviewer cannot be empty"),
+				v.Match(userReg).Error("reviewer must be a valid username"),
+			),
+		),
+		v.Field(&args.TeamReviewers, v.Each(
+			v.Required.Error("team reviewer cannot be empty"),
+			v.Match(userReg).Error("team reviewer must be a valid team name"),
+		)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	reviewArgs := remote.ReviewRequestArgs{
+		Repository:        repository,
+		PullRequestNumber: args.PullRequestNumber,
+		Reviewers:         args.Reviewers,
+		TeamReviewers:     args.TeamReviewers,
+	}
+	if add {
+		if err := client.RequestReviewers(ctx, reviewArgs); err != nil {
+			return TextErrorf("Failed to request reviewers: %v", err), nil, nil
+		}
+	} else {
+		if err := client.RemoveReviewRequests(ctx, reviewArgs); err != nil {
+			return TextErrorf("Failed to remove review requests: %v", err), nil, nil
+		}
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatReviewRequests(args.PullRequestNumber, args.Reviewers, args.TeamReviewers, add)
+	} else {
+		names := append([]string{}, args.Reviewers...)
+		for _, team := range args.TeamReviewers {
+			names = append(names, "team "+team)
+		}
+		if add {
+			responseText = fmt.Sprintf("Requested review on pull request #%d from: %s", args.PullRequestNumber, strings.Join(names, ", "))
+		} else {
+			responseText = fmt.Sprintf("Removed review requests on pull request #%d for: %s", args.PullRequestNumber, strings.Join(names, ", "))
+		}
+	}
+
+	return TextResult(responseText), &PullRequestReviewersResult{
+		PullRequestNumber: args.PullRequestNumber,
+		Reviewers:         args.Reviewers,
+		TeamReviewers:     args.TeamReviewers,
+	}, nil
+}
@@ -0,0 +1,374 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// reviewEvents maps the review events accepted by pr_review_create to remote review states
+var reviewEvents = map[string]string{
+	"APPROVE":         remote.ReviewStateApproved,
+	"REQUEST_CHANGES": remote.ReviewStateRequestChanges,
+	"COMMENT":         remote.ReviewStateComment,
+}
+
+// PullRequestReviewCommentArgs represents an inline comment attached to a new review
+type PullRequestReviewCommentArgs struct {
+	Path    string `json:"path"`              // File path relative to the repository root
+	Body    string `json:"body"`              // Comment text
+	NewLine int    `json:"new_line,omitzero"` // Line in the new version of the file
+	OldLine int    `json:"old_line,omitzero"` // Line in the old version of the file (for removed lines)
+}
+
+// PullRequestReviewCreateArgs represents the arguments for submitting a pull request review
+type PullRequestReviewCreateArgs struct {
+	Repository        string                         `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string                         `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int                            `json:"pull_request_number"`
+	Event             string                         `json:"event"`              // "APPROVE", "REQUEST_CHANGES", or "COMMENT"
+	Body              string                         `json:"body,omitzero"`      // Review summary
+	CommitID          string                         `json:"commit_id,omitzero"` // Commit being reviewed, defaults to the PR head
+	Comments          []PullRequestReviewCommentArgs `json:"comments,omitzero"`  // Line-anchored comments
+}
+
+// PullRequestReviewCreateResult represents the result data for the pr_review_create tool
+type PullRequestReviewCreateResult struct {
+	Review *remote.PullRequestReview `json:"review,omitempty"`
+}
+
+// PullRequestReviewListArgs represents the arguments for listing pull request reviews
+type PullRequestReviewListArgs struct {
+	Repository        string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int    `json:"pull_request_number"`
+	Limit             int    `json:"limit,omitzero"`
+	Offset            int    `json:"offset,omitzero"`
+}
+
+// PullRequestReviewList represents the result data for the pr_review_list tool
+type PullRequestReviewList struct {
+	Reviews []remote.PullRequestReview `json:"reviews"`
+	Total   int                        `json:"total"`
+	Limit   int                        `json:"limit"`
+	Offset  int                        `json:"offset"`
+}
+
+// PullRequestReviewCommentsListArgs represents the arguments for listing a review's inline comments
+type PullRequestReviewCommentsListArgs struct {
+	Repository        string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory         string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	PullRequestNumber int    `json:"pull_request_number"`
+	ReviewID          int    `json:"review_id"` // Review ID from pr_review_list
+}
+
+// PullRequestReviewCommentList represents the result data for the pr_review_comments_list tool
+type PullRequestReviewCommentList struct {
+	Comments []remote.PullRequestReviewComment `json:"comments"`
+}
+
+// validateReviewComment validates a single inline review comment
+func validateReviewComment(value any) error {
+	comment, ok := value.(PullRequestReviewCommentArgs)
+	if !ok {
+		return v.NewError("review_comment", "invalid review comment")
+	}
+	return v.ValidateStruct(&comment,
+		v.Field(&comment.Path, v.Required.Error("path is required")),
+		v.Field(&comment.Body, v.Required.Error("body is required"), v.Match(emptyReg).Error("body cannot be only whitespace")),
+		v.Field(&comment.NewLine,
+			v.Min(0),
+			v.When(comment.OldLine == 0, v.Required.Error("one of new_line or old_line is required")),
+			v.When(comment.OldLine != 0, v.Empty.Error("only one of new_line or old_line may be set")),
+		),
+		v.Field(&comment.OldLine, v.Min(0)),
+	)
+}
+
+// handlePullRequestReviewCreate handles the "pr_review_create" tool request.
+// It submits a review on a pull request with an optional summary and line-anchored comments.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number to review (must be positive)
+//   - event: Review verdict ("APPROVE", "REQUEST_CHANGES", or "COMMENT")
+//   - body: Review summary (required unless approving or adding inline comments)
+//   - commit_id: Commit being reviewed (optional, defaults to the PR head)
+//   - comments: Inline comments, each with path, body, and one of new_line or old_line
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The submitted review
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestReviewCreate(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewCreateArgs) (*mcp.CallToolResult, *PullRequestReviewCreateResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.Event,
+			v.Required.Error("event is required"),
+			v.In("APPROVE", "REQUEST_CHANGES", "COMMENT").Error("event must be 'APPROVE', 'REQUEST_CHANGES', or 'COMMENT'"),
+		),
+		v.Field(&args.Body, v.When(args.Event != "APPROVE" && len(args.Comments) == 0,
+			v.Required.Error("body is required unless approving or adding inline comments"),
+			v.Match(emptyReg).Error("body cannot be only whitespace"),
+		)),
+		v.Field(&args.Comments, v.Each(v.By(validateReviewComment))),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	comments := make([]remote.ReviewCommentArgs, len(args.Comments))
+	for i, comment := range args.Comments {
+		comments[i] = remote.ReviewCommentArgs{
+			Path:    comment.Path,
+			Body:    comment.Body,
+			NewLine: comment.NewLine,
+			OldLine: comment.OldLine,
+		}
+	}
+
+	// Submit the review
+	review, err := client.CreatePullRequestReview(ctx, remote.CreatePullRequestReviewArgs{
+		Repository:        repository,
+		PullRequestNumber: args.PullRequestNumber,
+		State:             reviewEvents[args.Event],
+		Body:              args.Body,
+		CommitID:          args.CommitID,
+		Comments:          comments,
+	})
+	if err != nil {
+		return TextErrorf("Failed to create pull request review: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatPullRequestReviewCreateSuccess(args.PullRequestNumber, review)
+	} else {
+		responseText = fmt.Sprintf("Review %d submitted on pull request #%d (%s)", review.ID, args.PullRequestNumber, review.State)
+	}
+
+	return TextResult(responseText), &PullRequestReviewCreateResult{Review: review}, nil
+}
+
+// handlePullRequestReviewList handles the "pr_review_list" tool request.
+// It lists the reviews submitted on a pull request with pagination.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number (must be positive)
+//   - limit: Maximum number of reviews to return (1-100, default 15)
+//   - offset: Number of reviews to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Reviews with reviewer, state, summary, and inline comment count
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestReviewList(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewListArgs) (*mcp.CallToolResult, *PullRequestReviewList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// List reviews
+	reviewList, err := client.ListPullRequestReviews(ctx, repository, args.PullRequestNumber, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list pull request reviews: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatPullRequestReviewList(reviewList.Reviews)
+	} else {
+		responseText = fmt.Sprintf("Found %d reviews", len(reviewList.Reviews))
+	}
+
+	return TextResult(responseText), &PullRequestReviewList{
+		Reviews: reviewList.Reviews,
+		Total:   reviewList.Total,
+		Limit:   reviewList.Limit,
+		Offset:  reviewList.Offset,
+	}, nil
+}
+
+// handlePullRequestReviewCommentsList handles the "pr_review_comments_list" tool request.
+// It lists the line-anchored comments attached to a single pull request review.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - pull_request_number: The pull request number (must be positive)
+//   - review_id: The review ID, as returned by pr_review_list (must be positive)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Inline comments with file path, line, diff hunk, and author
+//   - Error: Validation errors or API failures
+func (s *Server) handlePullRequestReviewCommentsList(ctx context.Context, request *mcp.CallToolRequest, args PullRequestReviewCommentsListArgs) (*mcp.CallToolResult, *PullRequestReviewCommentList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.PullRequestNumber, v.Required.Error("pull request number is required"), v.Min(1)),
+		v.Field(&args.ReviewID, v.Required.Error("review ID is required"), v.Min(1)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// List the review's inline comments
+	comments, err := client.ListPullRequestReviewComments(ctx, repository, args.PullRequestNumber, args.ReviewID)
+	if err != nil {
+		return TextErrorf("Failed to list review comments: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatReviewCommentList(comments)
+	} else {
+		responseText = fmt.Sprintf("Found %d review comments", len(comments))
+	}
+
+	return TextResult(responseText), &PullRequestReviewCommentList{Comments: comments}, nil
+}
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// reactionReg matches reaction names such as "+1", "eyes" or "thumbs_up"
+var reactionReg = regexp.MustCompile(`^[a-zA-Z0-9_+-]+$`)
+
+// reactionAliases maps the emoji of the default Forgejo/Gitea reactions to their names
+var reactionAliases = map[string]string{
+	"👍":  "+1",
+	"👎":  "-1",
+	"😄":  "laugh",
+	"🎉":  "hooray",
+	"😕":  "confused",
+	"❤️": "heart",
+	"❤":  "heart",
+	"🚀":  "rocket",
+	"👀":  "eyes",
+}
+
+// ReactionListArgs represents the arguments for listing reactions
+type ReactionListArgs struct {
+	Repository  string `json:"repository,omitzero"`   // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`    // Local directory path for automatic resolution
+	IssueNumber int    `json:"issue_number,omitzero"` // Issue or pull request number, for reactions on its body
+	CommentID   int    `json:"comment_id,omitzero"`   // Comment ID, for reactions on a comment
+}
+
+// ReactionArgs represents the arguments for adding or removing a reaction
+type ReactionArgs struct {
+	Repository  string `json:"repository,omitzero"`   // Repository path in "owner/repo" format
+	Directory   string `json:"directory,omitzero"`    // Local directory path for automatic resolution
+	IssueNumber int    `json:"issue_number,omitzero"` // Issue or pull request number, for reactions on its body
+	CommentID   int    `json:"comment_id,omitzero"`   // Comment ID, for reactions on a comment
+	Content     string `json:"content"`               // Reaction name, e.g. "+1" or "eyes", or its emoji
+}
+
+// ReactionListResult represents the result data for the reaction_list tool
+type ReactionListResult struct {
+	Reactions []remote.ReactionSummary `json:"reactions"`
+}
+
+// ReactionResult represents the result data for the reaction_add and reaction_remove tools
+type ReactionResult struct {
+	IssueNumber int    `json:"issue_number,omitempty"`
+	CommentID   int    `json:"comment_id,omitempty"`
+	Content     string `json:"content"`
+}
+
+// handleReactionList handles the "reaction_list" tool request.
+// It lists the reactions on an issue or pull request body, or on a comment, grouped by emoji.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - comment_id: The comment ID
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of
+// issue_number or comment_id must be provided.
+//
+// Returns:
+//   - Success: Reactions grouped by emoji with counts and users
+//   - Error: Validation errors or API failures
+func (s *Server) handleReactionList(ctx context.Context, request *mcp.CallToolRequest, args ReactionListArgs) (*mcp.CallToolResult, *ReactionListResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args, issueOrCommentTargetRules(&args.Repository, &args.Directory, &args.IssueNumber, &args.CommentID)...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	target, errResult := s.resolveIssueOrCommentTarget(args.Repository, args.Directory, args.IssueNumber, args.CommentID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	reactions, err := client.ListReactions(ctx, target)
+	if err != nil {
+		return TextErrorf("Failed to list reactions: %v", err), nil, nil
+	}
+
+	summary := summarizeReactions(reactions)
+	var responseText string
+	if s.compatMode {
+		responseText = FormatReactionList(summary)
+	} else {
+		responseText = fmt.Sprintf("Found %d reactions", len(reactions))
+	}
+
+	return TextResult(responseText), &ReactionListResult{Reactions: summary}, nil
+}
+
+// handleReactionAdd handles the "reaction_add" tool request.
+// It adds a reaction as the authenticated user to an issue or pull request body, or to a comment.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - comment_id: The comment ID
+//   - content: The reaction name, such as "+1" or "eyes", or its emoji
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of
+// issue_number or comment_id must be provided.
+//
+// Returns:
+//   - Success: The reaction that was added
+//   - Error: Validation errors, reactions not allowed by the server, or API failures
+func (s *Server) handleReactionAdd(ctx context.Context, request *mcp.CallToolRequest, args ReactionArgs) (*mcp.CallToolResult, *ReactionResult, error) {
+	return s.updateReaction(ctx, request, args, true)
+}
+
+// handleReactionRemove handles the "reaction_remove" tool request.
+// It removes a reaction of the authenticated user from an issue or pull request body, or from a comment.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - issue_number: The issue or pull request number
+//   - comment_id: The comment ID
+//   - content: The reaction name, such as "+1" or "eyes", or its emoji
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Exactly one of
+// issue_number or comment_id must be provided.
+//
+// Returns:
+//   - Success: The reaction that was removed
+//   - Error: Validation errors or API failures
+func (s *Server) handleReactionRemove(ctx context.Context, request *mcp.CallToolRequest, args ReactionArgs) (*mcp.CallToolResult, *ReactionResult, error) {
+	return s.updateReaction(ctx, request, args, false)
+}
+
+// updateReaction validates the request and adds or removes a reaction
+func (s *Server) updateReaction(ctx context.Context, request *mcp.CallToolRequest, args ReactionArgs, add bool) (*mcp.CallToolResult, *ReactionResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	args.Content = strings.TrimSpace(args.Content)
+	if name, ok := reactionAliases[args.Content]; ok {
+		args.Content = name
+	}
+
+	// Validate input arguments using ozzo-validation
+	rules := issueOrCommentTargetRules(&args.Repository, &args.Directory, &args.IssueNumber, &args.CommentID)
+	rules = append(rules, v.Field(&args.Content,
+		v.Required.Error("content is required"),
+		v.Match(reactionReg).Error("content must be a reaction name such as '+1' or 'eyes'"),
+	))
+	if err := v.ValidateStruct(&args, rules...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	target, errResult := s.resolveIssueOrCommentTarget(args.Repository, args.Directory, args.IssueNumber, args.CommentID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	if add {
+		if _, err := client.AddReaction(ctx, target, args.Content); err != nil {
+			return TextErrorf("Failed to add reaction: %v", err), nil, nil
+		}
+	} else {
+		if err := client.RemoveReaction(ctx, target, args.Content); err != nil {
+			return TextErrorf("Failed to remove reaction: %v", err), nil, nil
+		}
+	}
+
+	subject := fmt.Sprintf("#%d", args.IssueNumber)
+	if args.CommentID > 0 {
+		subject = fmt.Sprintf("comment %d", args.CommentID)
+	}
+	var responseText string
+	switch {
+	case add && s.compatMode:
+		responseText = fmt.Sprintf("Reaction added successfully. Content: %s, Target: %s", args.Content, subject)
+	case add:
+		responseText = fmt.Sprintf("Added %s reaction to %s", args.Content, subject)
+	case s.compatMode:
+		responseText = fmt.Sprintf("Reaction removed successfully. Content: %s, Target: %s", args.Content, subject)
+	default:
+		responseText = fmt.Sprintf("Removed %s reaction from %s", args.Content, subject)
+	}
+
+	return TextResult(responseText), &ReactionResult{
+		IssueNumber: args.IssueNumber,
+		CommentID:   args.CommentID,
+		Content:     args.Content,
+	}, nil
+}
+
+// issueOrCommentTargetRules returns the validation rules shared by the reaction and attachment tools
+func issueOrCommentTargetRules(repository, directory *string, issueNumber, commentID *int) []*v.FieldRules {
+	return []*v.FieldRules{
+		v.Field(repository, v.When(*directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(directory, v.When(*repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(*directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(*directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(issueNumber,
+			v.When(*commentID == 0, v.Required.Error("at least one of issue_number or comment_id must be provided")),
+			v.When(*commentID != 0, v.Empty.Error("only one of issue_number or comment_id may be set")),
+			v.Min(1),
+		),
+		v.Field(commentID, v.Min(1)),
+	}
+}
+
+// resolveIssueOrCommentTarget builds the target of the reaction and attachment tools, resolving
+// the directory to a repository
+func (s *Server) resolveIssueOrCommentTarget(repository, directory string, issueNumber, commentID int) (remote.IssueOrCommentTarget, *mcp.CallToolResult) {
+	if directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(directory)
+		if err != nil {
+			return remote.IssueOrCommentTarget{}, TextErrorf("Failed to resolve directory: %v", err)
+		}
+		repository = resolution.Repository
+	}
+	return remote.IssueOrCommentTarget{
+		Repository:  repository,
+		IssueNumber: issueNumber,
+		CommentID:   commentID,
+	}, nil
+}
+
+// summarizeReactions groups reactions by emoji, in order of first use
+func summarizeReactions(reactions []remote.Reaction) []remote.ReactionSummary {
+	summaries := []remote.ReactionSummary{}
+	index := map[string]int{}
+	for _, r := range reactions {
+		i, ok := index[r.Content]
+		if !ok {
+			i = len(summaries)
+			index[r.Content] = i
+			summaries = append(summaries, remote.ReactionSummary{Content: r.Content})
+		}
+		summaries[i].Count++
+		if r.User != "" {
+			summaries[i].Users = append(summaries[i].Users, r.User)
+		}
+	}
+	return summaries
+}
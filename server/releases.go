@@ -0,0 +1,499 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ReleaseListArgs represents the arguments for listing repository releases
+type ReleaseListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of releases to return
+	Offset     int    `json:"offset,omitzero"`     // Number of releases to skip
+}
+
+// ReleaseList represents the result data for the release_list tool
+type ReleaseList struct {
+	Releases []remote.Release `json:"releases"`
+	Total    int              `json:"total"`
+	Limit    int              `json:"limit"`
+	Offset   int              `json:"offset"`
+}
+
+// ReleaseGetArgs represents the arguments for getting the release of a tag
+type ReleaseGetArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Tag        string `json:"tag"`                 // Tag of the release
+}
+
+// ReleaseCreateArgs represents the arguments for creating a release
+type ReleaseCreateArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Tag        string `json:"tag"`                 // Tag of the release, created when it does not exist
+	Target     string `json:"target,omitzero"`     // Branch or commit SHA to create the tag from (default branch if not provided)
+	Name       string `json:"name,omitzero"`       // Release title (the tag if not provided)
+	Body       string `json:"body,omitzero"`       // Release notes
+	Draft      bool   `json:"draft,omitzero"`      // Create as an unpublished draft
+	Prerelease bool   `json:"prerelease,omitzero"` // Mark as a prerelease
+
+	Attachments []any `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects to upload as assets
+}
+
+// ReleaseEditArgs represents the arguments for editing the release of a tag.
+// Empty fields are left unchanged.
+type ReleaseEditArgs struct {
+	Repository string `json:"repository,omitzero"`  // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`   // Local directory path for automatic resolution
+	Tag        string `json:"tag"`                  // Current tag of the release
+	NewTag     string `json:"new_tag,omitzero"`     // New tag of the release
+	Target     string `json:"target,omitzero"`      // New branch or commit SHA to create a new tag from
+	Name       string `json:"name,omitzero"`        // New release title
+	Body       string `json:"body,omitzero"`        // New release notes
+	Draft      *bool  `json:"draft,omitempty"`      // Set false to publish a draft, true to unpublish
+	Prerelease *bool  `json:"prerelease,omitempty"` // Mark or unmark as a prerelease
+
+	Attachments []any `json:"attachments,omitzero"` // MCP image, audio, or embedded resource content objects to upload as assets
+}
+
+// ReleaseResult represents the result data for the release_get, release_create and release_edit tools
+type ReleaseResult struct {
+	Release *remote.Release `json:"release,omitempty"`
+}
+
+// handleReleaseList handles the "release_list" tool request.
+// It lists the releases of a repository, newest first.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - limit: Maximum number of releases to return (1-100, default 15)
+//   - offset: Number of releases to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Drafts are only listed
+// for users with write access to the repository.
+//
+// Returns:
+//   - Success: The releases with their assets and pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleReleaseList(ctx context.Context, request *mcp.CallToolRequest, args ReleaseListArgs) (*mcp.CallToolResult, *ReleaseList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	releases, err := client.ListReleases(ctx, repository, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list releases: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatReleaseList(releases.Releases)
+	} else {
+		responseText = fmt.Sprintf("Found %d releases", len(releases.Releases))
+	}
+
+	return TextResult(responseText), &ReleaseList{
+		Releases: releases.Releases,
+		Total:    releases.Total,
+		Limit:    releases.Limit,
+		Offset:   releases.Offset,
+	}, nil
+}
+
+// handleReleaseGet handles the "release_get" tool request.
+// It fetches the release of a tag with its notes and assets.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - tag: The tag of the release
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The release with its assets
+//   - Error: Validation errors, unknown tags, or API failures
+func (s *Server) handleReleaseGet(ctx context.Context, request *mcp.CallToolRequest, args ReleaseGetArgs) (*mcp.CallToolResult, *ReleaseResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Tag, v.Required.Error("tag is required")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	release, err := client.GetRelease(ctx, repository, args.Tag)
+	if err != nil {
+		return TextErrorf("Failed to get release: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatRelease(release)
+	} else {
+		responseText = fmt.Sprintf("Release %s: %s", release.TagName, release.Name)
+	}
+
+	return TextResult(responseText), &ReleaseResult{Release: release}, nil
+}
+
+// handleReleaseCreate handles the "release_create" tool request.
+// It creates a release, creating its tag from target when the tag does not exist, and uploads
+// attachments as release assets.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - tag: The tag of the release (required)
+//   - target: Branch or commit SHA to create the tag from (optional, defaults to the default branch)
+//   - name: The release title (optional, defaults to the tag)
+//   - body: The release notes (optional)
+//   - draft: Create as an unpublished draft (optional)
+//   - prerelease: Mark as a prerelease (optional)
+//   - attachments: MCP image, audio, or embedded resource content objects to upload as assets (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. Attachments require
+// attachment.enabled in the server configuration.
+//
+// Returns:
+//   - Success: The created release with its assets
+//   - Error: Validation errors or API failures
+func (s *Server) handleReleaseCreate(ctx context.Context, request *mcp.CallToolRequest, args ReleaseCreateArgs) (*mcp.CallToolResult, *ReleaseResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Tag,
+			v.Required.Error("tag is required"),
+			v.Match(emptyReg).Error("tag cannot be only whitespace"),
+		),
+		v.Field(&args.Name, v.Match(emptyReg).Error("name cannot be only whitespace")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	// Process attachments
+	files, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	name := args.Name
+	if name == "" {
+		name = args.Tag
+	}
+	release, err := client.CreateRelease(ctx, remote.CreateReleaseArgs{
+		Repository: repository,
+		TagName:    args.Tag,
+		Target:     args.Target,
+		Name:       name,
+		Body:       args.Body,
+		Draft:      args.Draft,
+		Prerelease: args.Prerelease,
+	})
+	if err != nil {
+		return TextErrorf("Failed to create release: %v", err), nil, nil
+	}
+
+	if err := uploadReleaseAssets(ctx, client, repository, release, files); err != nil {
+		return TextErrorf("Release %s was created but uploading assets failed: %v", release.TagName, err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatRelease(release)
+	} else {
+		responseText = fmt.Sprintf("Release created successfully: %s", release.TagName)
+	}
+
+	return TextResult(responseText), &ReleaseResult{Release: release}, nil
+}
+
+// handleReleaseEdit handles the "release_edit" tool request.
+// It changes the tag, target, title, notes, or draft and prerelease flags of the release of a
+// tag, and uploads attachments as additional release assets.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - tag: The current tag of the release
+//   - new_tag: The new tag (optional)
+//   - target: Branch or commit SHA to create a new tag from (optional)
+//   - name: The new release title (optional)
+//   - body: The new release notes (optional)
+//   - draft: false publishes a draft, true turns the release back into a draft (optional)
+//   - prerelease: Mark or unmark as a prerelease (optional)
+//   - attachments: MCP image, audio, or embedded resource content objects to upload as assets (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. At least one change or
+// attachment must be provided.
+//
+// Returns:
+//   - Success: The updated release with its assets
+//   - Error: Validation errors, unknown tags, or API failures
+func (s *Server) handleReleaseEdit(ctx context.Context, request *mcp.CallToolRequest, args ReleaseEditArgs) (*mcp.CallToolResult, *ReleaseResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Tag, v.Required.Error("tag is required")),
+		v.Field(&args.NewTag, v.Match(emptyReg).Error("new_tag cannot be only whitespace")),
+		v.Field(&args.Name, v.Match(emptyReg).Error("name cannot be only whitespace")),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	// Ensure at least one field is being changed or an asset added
+	changed := args.NewTag != "" || args.Target != "" || args.Name != "" || args.Body != "" ||
+		args.Draft != nil || args.Prerelease != nil
+	if !changed && len(args.Attachments) == 0 {
+		return TextError("At least one of new_tag, target, name, body, draft, prerelease, or attachments must be provided"), nil, nil
+	}
+
+	// Process attachments
+	files, err := s.processAttachments(args.Attachments)
+	if err != nil {
+		return TextErrorf("Invalid attachment: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	// Only send the fields that change
+	editArgs := remote.EditReleaseArgs{
+		Repository: repository,
+		TagName:    args.Tag,
+		Draft:      args.Draft,
+		Prerelease: args.Prerelease,
+	}
+	if args.NewTag != "" {
+		editArgs.NewTagName = &args.NewTag
+	}
+	if args.Target != "" {
+		editArgs.Target = &args.Target
+	}
+	if args.Name != "" {
+		editArgs.Name = &args.Name
+	}
+	if args.Body != "" {
+		editArgs.Body = &args.Body
+	}
+
+	var release *remote.Release
+	if changed {
+		release, err = client.EditRelease(ctx, editArgs)
+	} else {
+		// Only assets are added, the release itself is unchanged
+		release, err = client.GetRelease(ctx, repository, args.Tag)
+	}
+	if err != nil {
+		return TextErrorf("Failed to edit release: %v", err), nil, nil
+	}
+
+	if err := uploadReleaseAssets(ctx, client, repository, release, files); err != nil {
+		return TextErrorf("Release %s was edited but uploading assets failed: %v", release.TagName, err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatRelease(release)
+	} else {
+		state := "published"
+		if release.Draft {
+			state = "draft"
+		}
+		responseText = fmt.Sprintf("Release edited successfully: %s (%s)", release.TagName, state)
+	}
+
+	return TextResult(responseText), &ReleaseResult{Release: release}, nil
+}
+
+// uploadReleaseAssets uploads files as assets of a release and adds them to its asset list
+func uploadReleaseAssets(ctx context.Context, client remote.ClientInterface, repository string, release *remote.Release, files []remote.ProcessedAttachment) error {
+	for _, file := range files {
+		asset, err := client.UploadReleaseAsset(ctx, repository, release.ID, file)
+		if err != nil {
+			return err
+		}
+		release.Assets = append(release.Assets, *asset)
+	}
+	return nil
+}
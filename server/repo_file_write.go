@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// shaReg matches full SHA-1 and SHA-256 object IDs
+var shaReg = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// RepoFileWriteArgs represents the arguments for creating or updating a repository file
+type RepoFileWriteArgs struct {
+	Repository string                `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string                `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Path       string                `json:"path"`                // File path relative to the repository root
+	Content    string                `json:"content"`             // New file content
+	Encoding   string                `json:"encoding,omitzero"`   // "text" (default) or "base64"
+	Message    string                `json:"message"`             // Commit message
+	Branch     string                `json:"branch,omitzero"`     // Branch to commit to (default: the default branch)
+	NewBranch  string                `json:"new_branch,omitzero"` // Branch to create from branch for the commit
+	SHA        string                `json:"sha,omitzero"`        // SHA of the file from repo_file_get; required to update, omitted to create
+	Author     remote.CommitIdentity `json:"author,omitzero"`     // Commit author (default: the committer or authenticated user)
+	Committer  remote.CommitIdentity `json:"committer,omitzero"`  // Committer (default: the author or authenticated user)
+}
+
+// RepoFileDeleteArgs represents the arguments for deleting a repository file
+type RepoFileDeleteArgs struct {
+	Repository string                `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string                `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Path       string                `json:"path"`                // File path relative to the repository root
+	Message    string                `json:"message"`             // Commit message
+	Branch     string                `json:"branch,omitzero"`     // Branch to commit to (default: the default branch)
+	NewBranch  string                `json:"new_branch,omitzero"` // Branch to create from branch for the commit
+	SHA        string                `json:"sha"`                 // SHA of the file from repo_file_get
+	Author     remote.CommitIdentity `json:"author,omitzero"`     // Commit author (default: the committer or authenticated user)
+	Committer  remote.CommitIdentity `json:"committer,omitzero"`  // Committer (default: the author or authenticated user)
+}
+
+// RepoFileChangeResult represents the result data for the repo_file_write and repo_file_delete tools
+type RepoFileChangeResult struct {
+	Action string            `json:"action"` // "created", "updated" or "deleted"
+	Commit remote.FileCommit `json:"commit"`
+}
+
+// handleRepoFileWrite handles the "repo_file_write" tool request.
+// It creates or updates a file on a branch of the remote repository in a single commit,
+// without needing a local clone.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - path: The file path relative to the repository root
+//   - content: The new file content
+//   - encoding: "text" (default) or "base64" for binary content
+//   - message: The commit message
+//   - branch: The branch to commit to (optional, defaults to the default branch)
+//   - new_branch: A branch to create from branch for the commit (optional)
+//   - sha: The SHA of the file as returned by repo_file_get; required to update a file, omitted to create one
+//   - author: Commit author name and email (optional)
+//   - committer: Committer name and email (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. A write without sha fails
+// when the file exists, and a write with a sha fails when the file changed since it was read,
+// so concurrent edits are rejected instead of overwritten.
+//
+// Returns:
+//   - Success: The new file SHA and the commit
+//   - Error: Validation errors, conflicting changes, or API failures
+func (s *Server) handleRepoFileWrite(ctx context.Context, request *mcp.CallToolRequest, args RepoFileWriteArgs) (*mcp.CallToolResult, *RepoFileChangeResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default encoding if not provided
+	if args.Encoding == "" {
+		args.Encoding = "text"
+	}
+
+	// Validate input arguments using ozzo-validation
+	rules := fileChangeRules(&args.Repository, &args.Directory, &args.Path, &args.Message, &args.Author, &args.Committer)
+	rules = append(rules,
+		v.Field(&args.Encoding, v.In("text", "base64").Error("encoding must be 'text' or 'base64'")),
+		v.Field(&args.SHA, v.Match(shaReg).Error("sha must be a full SHA as returned by repo_file_get")),
+	)
+	if err := v.ValidateStruct(&args, rules...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	content := []byte(args.Content)
+	if args.Encoding == "base64" {
+		var err error
+		if content, err = base64.StdEncoding.DecodeString(args.Content); err != nil {
+			return TextErrorf("Invalid request: content: invalid base64: %v", err), nil, nil
+		}
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	commit, err := client.WriteFile(ctx, remote.WriteFileArgs{
+		FileChangeArgs: remote.FileChangeArgs{
+			Repository: repository,
+			Path:       args.Path,
+			Message:    args.Message,
+			Branch:     args.Branch,
+			NewBranch:  args.NewBranch,
+			SHA:        args.SHA,
+			Author:     args.Author,
+			Committer:  args.Committer,
+		},
+		Content: content,
+	})
+	if errors.Is(err, remote.ErrFileConflict) {
+		return TextErrorf("Refusing to write file: %v (read it again with repo_file_get and pass its current sha)", err), nil, nil
+	}
+	if err != nil {
+		return TextErrorf("Failed to write file: %v", err), nil, nil
+	}
+
+	action := "updated"
+	if args.SHA == "" {
+		action = "created"
+	}
+	return s.fileChangeResult(action, commit)
+}
+
+// handleRepoFileDelete handles the "repo_file_delete" tool request.
+// It deletes a file from a branch of the remote repository in a single commit, without
+// needing a local clone.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - path: The file path relative to the repository root
+//   - message: The commit message
+//   - branch: The branch to commit to (optional, defaults to the default branch)
+//   - new_branch: A branch to create from branch for the commit (optional)
+//   - sha: The SHA of the file as returned by repo_file_get
+//   - author: Commit author name and email (optional)
+//   - committer: Committer name and email (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The delete fails when the
+// file changed since it was read.
+//
+// Returns:
+//   - Success: The deleted path and the branch
+//   - Error: Validation errors, conflicting changes, or API failures
+func (s *Server) handleRepoFileDelete(ctx context.Context, request *mcp.CallToolRequest, args RepoFileDeleteArgs) (*mcp.CallToolResult, *RepoFileChangeResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	rules := fileChangeRules(&args.Repository, &args.Directory, &args.Path, &args.Message, &args.Author, &args.Committer)
+	rules = append(rules, v.Field(&args.SHA,
+		v.Required.Error("sha is required, read it with repo_file_get"),
+		v.Match(shaReg).Error("sha must be a full SHA as returned by repo_file_get"),
+	))
+	if err := v.ValidateStruct(&args, rules...); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	commit, err := client.DeleteFile(ctx, remote.FileChangeArgs{
+		Repository: repository,
+		Path:       args.Path,
+		Message:    args.Message,
+		Branch:     args.Branch,
+		NewBranch:  args.NewBranch,
+		SHA:        args.SHA,
+		Author:     args.Author,
+		Committer:  args.Committer,
+	})
+	if errors.Is(err, remote.ErrFileConflict) {
+		return TextErrorf("Refusing to delete file: %v (read it again with repo_file_get and pass its current sha)", err), nil, nil
+	}
+	if err != nil {
+		return TextErrorf("Failed to delete file: %v", err), nil, nil
+	}
+
+	return s.fileChangeResult("deleted", commit)
+}
+
+// fileChangeResult builds the result of a file write or delete
+func (s *Server) fileChangeResult(action string, commit *remote.FileCommit) (*mcp.CallToolResult, *RepoFileChangeResult, error) {
+	branch := commit.Branch
+	if branch == "" {
+		branch = "the default branch"
+	}
+	var responseText string
+	if s.compatMode {
+		responseText = FormatFileChange(action, commit)
+	} else {
+		responseText = fmt.Sprintf("File %s %s on %s", commit.Path, action, branch)
+	}
+
+	return TextResult(responseText), &RepoFileChangeResult{Action: action, Commit: *commit}, nil
+}
+
+// fileChangeRules returns the validation rules shared by the file write and delete tools
+func fileChangeRules(repository, directory, path, message *string, author, committer *remote.CommitIdentity) []*v.FieldRules {
+	return []*v.FieldRules{
+		v.Field(repository, v.When(*directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(directory, v.When(*repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(*directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(*directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(path, v.Required.Error("path is required")),
+		v.Field(message, v.Required.Error("commit message is required"), v.Match(emptyReg).Error("commit message is required")),
+		v.Field(author, v.By(commitIdentityRule)),
+		v.Field(committer, v.By(commitIdentityRule)),
+	}
+}
+
+// commitIdentityRule requires a commit identity to have both a name and an email, or neither
+func commitIdentityRule(value any) error {
+	identity, _ := value.(remote.CommitIdentity)
+	if (identity.Name == "") != (identity.Email == "") {
+		return v.NewError("identity", "both name and email must be provided")
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultTreeLimit is the number of tree entries returned when no limit is given
+const defaultTreeLimit = 500
+
+// DefaultMaxFileSize is the size in bytes of the largest file read by repo_file_get when the
+// configuration sets no limit
+const DefaultMaxFileSize = 1024 * 1024
+
+// binarySniffLen is the number of leading bytes searched for NUL bytes to detect binary files
+const binarySniffLen = 8000
+
+// RepoFileGetArgs represents the arguments for reading a repository file
+type RepoFileGetArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Path       string `json:"path"`                // File path relative to the repository root
+	Ref        string `json:"ref,omitzero"`        // Branch, tag or commit SHA (default: the default branch)
+	StartLine  int    `json:"start_line,omitzero"` // First line to return, starting at 1
+	EndLine    int    `json:"end_line,omitzero"`   // Last line to return, inclusive
+}
+
+// RepoFileGetResult represents the result data for the repo_file_get tool
+type RepoFileGetResult struct {
+	File       remote.RepositoryFile `json:"file"`
+	Content    string                `json:"content,omitempty"` // Selected lines, empty for binary files
+	Binary     bool                  `json:"binary"`
+	TotalLines int                   `json:"total_lines,omitempty"`
+	StartLine  int                   `json:"start_line,omitempty"`
+	EndLine    int                   `json:"end_line,omitempty"`
+}
+
+// RepoTreeListArgs represents the arguments for listing a repository tree
+type RepoTreeListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Path       string `json:"path,omitzero"`       // Directory relative to the repository root (default: the root)
+	Ref        string `json:"ref,omitzero"`        // Branch, tag or commit SHA (default: the default branch)
+	Recursive  bool   `json:"recursive,omitzero"`  // Include the entries of subdirectories
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of entries (1-5000, default 500)
+}
+
+// RepoTreeListResult represents the result data for the repo_tree_list tool
+type RepoTreeListResult struct {
+	Ref       string             `json:"ref"`
+	Path      string             `json:"path,omitempty"`
+	SHA       string             `json:"sha"`
+	Entries   []remote.TreeEntry `json:"entries"`
+	Truncated bool               `json:"truncated"` // Whether entries were left out because of the limit
+}
+
+// handleRepoFileGet handles the "repo_file_get" tool request.
+// It reads a file from the remote repository at a branch, tag or commit, optionally
+// limited to a range of lines, without needing a local checkout.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - path: The file path relative to the repository root
+//   - ref: Branch, tag or commit SHA (optional, defaults to the default branch)
+//   - start_line: First line to return, starting at 1 (optional)
+//   - end_line: Last line to return, inclusive (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution. The ref is resolved on
+// the remote, so local commits that were not pushed are not visible. Files larger than the
+// configured max_file_size are refused.
+//
+// Returns:
+//   - Success: File metadata with the selected lines, or only metadata for binary files
+//   - Error: Validation errors, line ranges outside the file, files over the size limit, or API failures
+func (s *Server) handleRepoFileGet(ctx context.Context, request *mcp.CallToolRequest, args RepoFileGetArgs) (*mcp.CallToolResult, *RepoFileGetResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Path, v.Required.Error("path is required")),
+		v.Field(&args.StartLine, v.Min(0)),
+		v.Field(&args.EndLine, v.Min(0), v.When(args.EndLine != 0 && args.StartLine != 0,
+			v.Min(args.StartLine).Error("end_line must not be before start_line"),
+		)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	maxSize := s.config.MaxFileSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxFileSize
+	}
+	file, err := client.GetRepositoryFile(ctx, repository, args.Ref, args.Path, maxSize)
+	if err != nil {
+		return TextErrorf("Failed to get file: %v", err), nil, nil
+	}
+
+	result := &RepoFileGetResult{File: *file}
+	if isBinaryContent(file.Content) {
+		result.Binary = true
+	} else if err := selectFileLines(result, string(file.Content), args.StartLine, args.EndLine); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	var responseText string
+	switch {
+	case s.compatMode:
+		responseText = FormatRepoFile(result)
+	case result.Binary:
+		responseText = fmt.Sprintf("File %s is binary (%d bytes)", file.Path, file.Size)
+	default:
+		responseText = fmt.Sprintf("Returned lines %d-%d of %d from %s", result.StartLine, result.EndLine, result.TotalLines, file.Path)
+	}
+
+	return TextResult(responseText), result, nil
+}
+
+// handleRepoTreeList handles the "repo_tree_list" tool request.
+// It lists the files and directories of the remote repository at a branch, tag or commit,
+// with their sizes and SHAs, without needing a local checkout.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - path: Directory relative to the repository root (optional, defaults to the root)
+//   - ref: Branch, tag or commit SHA (optional, defaults to the default branch)
+//   - recursive: Include the entries of subdirectories (optional, default false)
+//   - limit: Maximum number of entries to return (1-5000, default 500)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: Tree entries with paths relative to the repository root, types, sizes and SHAs
+//   - Error: Validation errors, unknown directories, or API failures
+func (s *Server) handleRepoTreeList(ctx context.Context, request *mcp.CallToolRequest, args RepoTreeListArgs) (*mcp.CallToolResult, *RepoTreeListResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = defaultTreeLimit
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(5000)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	path := strings.Trim(args.Path, "/")
+	tree, err := client.ListRepositoryTree(ctx, repository, args.Ref, path, args.Recursive, args.Limit)
+	if err != nil {
+		return TextErrorf("Failed to list tree: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatRepoTree(tree)
+	} else {
+		responseText = fmt.Sprintf("Found %d entries at %s", len(tree.Entries), tree.Ref)
+	}
+
+	return TextResult(responseText), &RepoTreeListResult{
+		Ref:       tree.Ref,
+		Path:      path,
+		SHA:       tree.SHA,
+		Entries:   tree.Entries,
+		Truncated: tree.Truncated,
+	}, nil
+}
+
+// isBinaryContent reports whether data looks binary: it holds a NUL byte near the start or is not UTF-8
+func isBinaryContent(data []byte) bool {
+	return bytes.IndexByte(data[:min(len(data), binarySniffLen)], 0) >= 0 || !utf8.Valid(data)
+}
+
+// selectFileLines sets the content of result to lines start through end of content. A zero
+// start or end selects from the first or through the last line.
+func selectFileLines(result *RepoFileGetResult, content string, start, end int) error {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	result.TotalLines = len(lines)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	if start == 0 {
+		start = 1
+	}
+	if end == 0 || end > len(lines) {
+		end = len(lines)
+	}
+	if start > len(lines) {
+		return fmt.Errorf("start_line %d is beyond the end of the file (%d lines)", start, len(lines))
+	}
+
+	result.StartLine = start
+	result.EndLine = end
+	result.Content = strings.Join(lines[start-1:end], "")
+	return nil
+}
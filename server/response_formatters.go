@@ -20,6 +20,23 @@ func FormatIssueList(issues []remote.Issue) string {
 	return builder.String()
 }
 
+// FormatIssueSearch creates a human-readable summary of cross-repository issue search results
+func FormatIssueSearch(issues []remote.Issue) string {
+	if len(issues) == 0 {
+		return "No issues found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d issues:\n", len(issues))
+	for _, issue := range issues {
+		kind := "issue"
+		if issue.PullRequest {
+			kind = "pull request"
+		}
+		fmt.Fprintf(&builder, "- %s#%d: %s (%s, %s)\n", issue.Repository, issue.Number, issue.Title, kind, issue.State)
+	}
+	return builder.String()
+}
+
 // FormatPullRequestList creates a human-readable summary of pull requests
 func FormatPullRequestList(pullRequests []remote.PullRequest) string {
 	if len(pullRequests) == 0 {
@@ -85,6 +102,191 @@ func FormatPullRequestDetails(pr *remote.PullRequestDetails) string {
 	return builder.String()
 }
 
+// FormatIssueFetch creates detailed issue information including metadata and reactions
+func FormatIssueFetch(issue *remote.IssueDetails) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Issue #%d: %s\n", issue.Number, issue.Title)
+	fmt.Fprintf(&builder, "State: %s\n", issue.State)
+	if issue.ClosedAt != "" {
+		fmt.Fprintf(&builder, "Closed: %s\n", issue.ClosedAt)
+	}
+	fmt.Fprintf(&builder, "Author: %s\n", issue.User)
+	fmt.Fprintf(&builder, "Created: %s\n", issue.CreatedAt)
+	fmt.Fprintf(&builder, "Updated: %s\n", issue.UpdatedAt)
+	if issue.Body != "" {
+		fmt.Fprintf(&builder, "Body:\n%s\n", issue.Body)
+	}
+
+	if len(issue.Assignees) > 0 {
+		fmt.Fprintf(&builder, "Assignees: %s\n", strings.Join(issue.Assignees, ", "))
+	}
+
+	if len(issue.Labels) > 0 {
+		names := make([]string, len(issue.Labels))
+		for i, label := range issue.Labels {
+			names[i] = label.Name
+		}
+		fmt.Fprintf(&builder, "Labels: %s\n", strings.Join(names, ", "))
+	}
+
+	if issue.Milestone != nil {
+		fmt.Fprintf(&builder, "Milestone: %s\n", issue.Milestone.Title)
+	}
+	if issue.DueDate != "" {
+		fmt.Fprintf(&builder, "Due: %s\n", issue.DueDate)
+	}
+
+	fmt.Fprintf(&builder, "Comments: %d\n", issue.Comments)
+	if issue.IsLocked {
+		builder.WriteString("Locked: true\n")
+	}
+
+	if len(issue.Reactions) > 0 {
+		fmt.Fprintf(&builder, "Reactions: %s\n", FormatReactionSummary(issue.Reactions))
+	}
+
+	if pr := issue.PullRequest; pr != nil {
+		fmt.Fprintf(&builder, "Pull Request: #%d", pr.Number)
+		if pr.Merged {
+			fmt.Fprintf(&builder, " (merged %s)", pr.MergedAt)
+		}
+		builder.WriteString("\n")
+	}
+
+	// URL
+	fmt.Fprintf(&builder, "URL: %s\n", issue.HTMLURL)
+
+	return builder.String()
+}
+
+// FormatIssueTimeline creates a chronological list of issue timeline events
+func FormatIssueTimeline(number int, events []remote.TimelineEvent) string {
+	if len(events) == 0 {
+		return fmt.Sprintf("No timeline events found for #%d", number)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Timeline of #%d (%d events):\n", number, len(events))
+	for _, event := range events {
+		fmt.Fprintf(&builder, "- %s %s %s\n", event.Created, event.Actor, event.Summary)
+		if event.Body != "" {
+			fmt.Fprintf(&builder, "  %s\n", strings.ReplaceAll(event.Body, "\n", "\n  "))
+		}
+	}
+	return builder.String()
+}
+
+// FormatCheckSummary creates a one-line summary of CI checks
+func FormatCheckSummary(summary *CheckSummary) string {
+	if summary.Total == 0 {
+		return "CI: no checks reported\n"
+	}
+	return fmt.Sprintf("CI: %s (%d passed, %d pending, %d failed, %d warnings of %d checks)\n",
+		summary.State, summary.Success, summary.Pending, summary.Failed, summary.Warning, summary.Total)
+}
+
+// FormatCommitStatus creates a human-readable list of CI checks for a commit
+func FormatCommitStatus(status *remote.CombinedCommitStatus, summary *CheckSummary) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Commit %s\n", status.SHA)
+	builder.WriteString(FormatCheckSummary(summary))
+	for _, check := range status.Statuses {
+		fmt.Fprintf(&builder, "- %s: %s", check.Context, check.State)
+		if check.Description != "" {
+			fmt.Fprintf(&builder, " - %s", check.Description)
+		}
+		if check.TargetURL != "" {
+			fmt.Fprintf(&builder, " (%s)", check.TargetURL)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// FormatActionRunList creates a human-readable summary of workflow runs
+func FormatActionRunList(runs []remote.ActionRun) string {
+	if len(runs) == 0 {
+		return "No action runs found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d action runs:\n", len(runs))
+	for _, run := range runs {
+		fmt.Fprintf(&builder, "- Run %d (#%d) %s: %s on %s (%s)\n", run.ID, run.RunNumber, run.WorkflowID, run.Status, run.Branch, run.Title)
+	}
+	return builder.String()
+}
+
+// FormatActionJobList creates a human-readable summary of workflow jobs
+func FormatActionJobList(jobs []remote.ActionJob) string {
+	if len(jobs) == 0 {
+		return "No jobs found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d jobs:\n", len(jobs))
+	for _, job := range jobs {
+		fmt.Fprintf(&builder, "- Job %d %s: %s", job.ID, job.Name, job.Status)
+		if job.Conclusion != "" {
+			fmt.Fprintf(&builder, " (%s)", job.Conclusion)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// FormatActionJobLog creates a human-readable job log excerpt with a header describing the selection
+func FormatActionJobLog(jobID int, result *ActionJobLogResult) string {
+	if result.ReturnedLines == 0 {
+		return fmt.Sprintf("No matching log lines for job %d", jobID)
+	}
+	return fmt.Sprintf("Log of job %d (%d of %d lines):\n%s", jobID, result.ReturnedLines, result.TotalLines, result.Log)
+}
+
+// FormatLabelList creates a human-readable summary of repository labels
+func FormatLabelList(labels []remote.Label) string {
+	if len(labels) == 0 {
+		return "No labels found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d labels:\n", len(labels))
+	for _, label := range labels {
+		fmt.Fprintf(&builder, "- %s (#%s)", label.Name, strings.TrimPrefix(label.Color, "#"))
+		if label.Description != "" {
+			fmt.Fprintf(&builder, ": %s", label.Description)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// FormatIssueLabels creates a human-readable summary of the labels on an issue
+func FormatIssueLabels(number int, labels []remote.Label) string {
+	if len(labels) == 0 {
+		return fmt.Sprintf("Issue #%d has no labels", number)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Issue #%d labels:\n", number)
+	for _, label := range labels {
+		fmt.Fprintf(&builder, "- %s\n", label.Name)
+	}
+	return builder.String()
+}
+
+// FormatMilestoneList creates a human-readable summary of repository milestones
+func FormatMilestoneList(milestones []remote.Milestone) string {
+	if len(milestones) == 0 {
+		return "No milestones found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d milestones:\n", len(milestones))
+	for _, milestone := range milestones {
+		fmt.Fprintf(&builder, "- %s (%s, %d open, %d closed)", milestone.Title, milestone.State, milestone.OpenIssues, milestone.ClosedIssues)
+		if milestone.DueDate != "" {
+			fmt.Fprintf(&builder, " due %s", milestone.DueDate)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
 // FormatIssueDetails creates detailed issue information
 func FormatIssueDetails(issue *remote.Issue) string {
 	var builder strings.Builder
@@ -146,6 +348,116 @@ func FormatPullRequestEditSuccess(pr *remote.PullRequest) string {
 	return responseText
 }
 
+// FormatPullRequestMergeSuccess creates success message for PR merging
+func FormatPullRequestMergeSuccess(number int, result *remote.MergeResult, deleteBranch bool) string {
+	if result.Scheduled {
+		return fmt.Sprintf("Pull request #%d scheduled to merge (%s) when all checks succeed", number, result.Style)
+	}
+	responseText := fmt.Sprintf("Pull request #%d merged successfully using %s", number, result.Style)
+	if deleteBranch {
+		responseText += ", head branch deleted"
+	}
+	return responseText
+}
+
+// FormatPullRequestReviewCreateSuccess creates success message for review submission
+func FormatPullRequestReviewCreateSuccess(number int, review *remote.PullRequestReview) string {
+	responseText := fmt.Sprintf("Review submitted successfully. ID: %d, Pull Request: #%d, State: %s, Reviewer: %s",
+		review.ID, number, review.State, review.Reviewer)
+	if review.CommentsCount > 0 {
+		responseText += fmt.Sprintf(", Inline comments: %d", review.CommentsCount)
+	}
+	responseText += "\n"
+	if review.Body != "" {
+		responseText += fmt.Sprintf("Body: %s\n", review.Body)
+	}
+	return responseText
+}
+
+// FormatPullRequestReviewList creates a human-readable summary of pull request reviews
+func FormatPullRequestReviewList(reviews []remote.PullRequestReview) string {
+	if len(reviews) == 0 {
+		return "No reviews found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d reviews:\n", len(reviews))
+	for _, review := range reviews {
+		fmt.Fprintf(&builder, "- Review %d by %s: %s", review.ID, review.Reviewer, review.State)
+		if review.CommentsCount > 0 {
+			fmt.Fprintf(&builder, " (%d inline comments)", review.CommentsCount)
+		}
+		if review.Dismissed {
+			builder.WriteString(" [dismissed]")
+		} else if review.Stale {
+			builder.WriteString(" [stale]")
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// FormatReviewRequests creates a human-readable summary of requested or removed pull request reviewers
+func FormatReviewRequests(number int, reviewers, teams []string, requested bool) string {
+	var builder strings.Builder
+	if requested {
+		fmt.Fprintf(&builder, "Pull request #%d review requested from:\n", number)
+	} else {
+		fmt.Fprintf(&builder, "Pull request #%d review requests removed for:\n", number)
+	}
+	for _, reviewer := range reviewers {
+		fmt.Fprintf(&builder, "- %s\n", reviewer)
+	}
+	for _, team := range teams {
+		fmt.Fprintf(&builder, "- team %s\n", team)
+	}
+	return builder.String()
+}
+
+// FormatReviewCommentList creates a human-readable summary of inline review comments
+func FormatReviewCommentList(comments []remote.PullRequestReviewComment) string {
+	if len(comments) == 0 {
+		return "No review comments found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d review comments:\n", len(comments))
+	for _, comment := range comments {
+		line := comment.Line
+		if line == 0 {
+			line = comment.OldLine
+		}
+		fmt.Fprintf(&builder, "- %s:%d by %s: %s\n", comment.Path, line, comment.Author, comment.Body)
+	}
+	return builder.String()
+}
+
+// FormatPullRequestDiff creates a human-readable pull request diff with a file summary header
+func FormatPullRequestDiff(number int, result *PullRequestDiffResult) string {
+	if len(result.Files) == 0 {
+		return fmt.Sprintf("No changed files found in pull request #%d", number)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Diff of pull request #%d (files %d-%d of %d):\n", number, result.Offset+1, result.Offset+len(result.Files), result.Total)
+	builder.WriteString(result.Diff)
+	return builder.String()
+}
+
+// FormatPullRequestFileList creates a human-readable summary of files changed by a pull request
+func FormatPullRequestFileList(files []remote.ChangedFile) string {
+	if len(files) == 0 {
+		return "No changed files found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d changed files:\n", len(files))
+	for _, file := range files {
+		fmt.Fprintf(&builder, "- %s (%s, +%d -%d)", file.Filename, file.Status, file.Additions, file.Deletions)
+		if file.PreviousFilename != "" {
+			fmt.Fprintf(&builder, " renamed from %s", file.PreviousFilename)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
 // FormatCommentList creates a human-readable summary of comments
 func FormatCommentList(comments []remote.Comment) string {
 	if len(comments) == 0 {
@@ -181,3 +493,270 @@ func FormatCommentCreateSuccess(comment *remote.Comment) string {
 func FormatCommentEditSuccess(comment *remote.Comment) string {
 	return fmt.Sprintf("Comment edited successfully by %s", comment.Author)
 }
+
+// FormatCommentDeleteSuccess creates success message for comment deletion
+func FormatCommentDeleteSuccess(commentID, number int) string {
+	return fmt.Sprintf("Comment %d deleted successfully from #%d", commentID, number)
+}
+
+// FormatReactionSummary creates a compact summary of reaction counts, e.g. "+1 2, eyes 1"
+func FormatReactionSummary(reactions []remote.ReactionSummary) string {
+	parts := make([]string, len(reactions))
+	for i, reaction := range reactions {
+		parts[i] = fmt.Sprintf("%s %d", reaction.Content, reaction.Count)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatReactionList creates a human-readable summary of reactions grouped by emoji
+func FormatReactionList(reactions []remote.ReactionSummary) string {
+	if len(reactions) == 0 {
+		return "No reactions found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d reaction types:\n", len(reactions))
+	for _, reaction := range reactions {
+		fmt.Fprintf(&builder, "- %s (%d): %s\n", reaction.Content, reaction.Count, strings.Join(reaction.Users, ", "))
+	}
+	return builder.String()
+}
+
+// FormatNotificationDigest creates a human-readable, prioritized list of unread notifications
+func FormatNotificationDigest(items []NotificationDigestItem) string {
+	if len(items) == 0 {
+		return "No unread notifications"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d unread notifications:\n", len(items))
+	for i, item := range items {
+		fmt.Fprintf(&builder, "%d. [%s] %s#%d %s (notification %d)\n", i+1, strings.Join(item.Reasons, ", "), item.Repository, item.Number, item.Title, item.ID)
+		if item.CIState != "" {
+			fmt.Fprintf(&builder, "   CI: %s\n", item.CIState)
+		}
+		if item.LatestCommentAuthor != "" {
+			fmt.Fprintf(&builder, "   Latest comment by %s: %s\n", item.LatestCommentAuthor, item.LatestCommentExcerpt)
+		}
+	}
+	return builder.String()
+}
+
+// FormatAttachmentList creates a human-readable list of attachments
+func FormatAttachmentList(attachments []remote.Attachment) string {
+	if len(attachments) == 0 {
+		return "No attachments found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d attachments:\n", len(attachments))
+	for _, attachment := range attachments {
+		fmt.Fprintf(&builder, "- %d: %s (%d bytes) %s\n", attachment.ID, attachment.Name, attachment.Size, attachment.DownloadURL)
+	}
+	return builder.String()
+}
+
+// FormatAttachment creates a human-readable description of a downloaded attachment
+func FormatAttachment(attachment *remote.Attachment, mimeType string) string {
+	return fmt.Sprintf("Attachment retrieved successfully. ID: %d, Name: %s, Size: %d, Type: %s", attachment.ID, attachment.Name, attachment.Size, mimeType)
+}
+
+// FormatRepoFile creates a human-readable view of a repository file with line numbers
+func FormatRepoFile(result *RepoFileGetResult) string {
+	if result.Binary {
+		return fmt.Sprintf("File %s is binary (%d bytes, SHA %s)", result.File.Path, result.File.Size, result.File.SHA)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "File %s (lines %d-%d of %d, SHA %s):\n", result.File.Path, result.StartLine, result.EndLine, result.TotalLines, result.File.SHA)
+	for i, line := range strings.SplitAfter(strings.TrimSuffix(result.Content, "\n"), "\n") {
+		fmt.Fprintf(&builder, "%6d  %s", result.StartLine+i, line)
+	}
+	builder.WriteString("\n")
+	return builder.String()
+}
+
+// FormatRepoTree creates a human-readable listing of repository tree entries
+func FormatRepoTree(tree *remote.RepositoryTree) string {
+	if len(tree.Entries) == 0 {
+		return fmt.Sprintf("No entries found at %s", tree.Ref)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d entries at %s:\n", len(tree.Entries), tree.Ref)
+	for _, entry := range tree.Entries {
+		if entry.Type == "tree" {
+			fmt.Fprintf(&builder, "- %s/\n", entry.Path)
+		} else {
+			fmt.Fprintf(&builder, "- %s (%d bytes)\n", entry.Path, entry.Size)
+		}
+	}
+	if tree.Truncated {
+		builder.WriteString("... more entries omitted, raise the limit or narrow the path\n")
+	}
+	return builder.String()
+}
+
+// FormatFileChange creates success message for a file write or delete
+func FormatFileChange(action string, commit *remote.FileCommit) string {
+	text := fmt.Sprintf("File %s successfully. Path: %s", action, commit.Path)
+	if commit.Branch != "" {
+		text += ", Branch: " + commit.Branch
+	}
+	if commit.SHA != "" {
+		text += ", SHA: " + commit.SHA
+	}
+	if commit.CommitSHA != "" {
+		text += ", Commit: " + commit.CommitSHA
+	}
+	return text
+}
+
+// FormatBranchList creates a human-readable list of branches
+func FormatBranchList(branches []remote.Branch) string {
+	if len(branches) == 0 {
+		return "No branches found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d branches:\n", len(branches))
+	for _, branch := range branches {
+		builder.WriteString(formatBranchLine(branch))
+	}
+	return builder.String()
+}
+
+// FormatBranch creates success message for a created branch
+func FormatBranch(branch *remote.Branch) string {
+	return "Branch created successfully:\n" + formatBranchLine(*branch)
+}
+
+// formatBranchLine formats a branch with its last commit as a list item
+func formatBranchLine(branch remote.Branch) string {
+	text := fmt.Sprintf("- %s", branch.Name)
+	if branch.Protected {
+		text += " (protected)"
+	}
+	if branch.Commit.SHA != "" {
+		text += ": " + formatCommitLine(branch.Commit)
+	}
+	return text + "\n"
+}
+
+// FormatBranchComparison creates a human-readable comparison of two branches
+func FormatBranchComparison(comparison *remote.BranchComparison) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s is %d commits ahead of and %d commits behind %s\n", comparison.Head, comparison.AheadBy, comparison.BehindBy, comparison.Base)
+	if len(comparison.AheadCommits) > 0 {
+		fmt.Fprintf(&builder, "\nCommits on %s not on %s:\n", comparison.Head, comparison.Base)
+		for _, commit := range comparison.AheadCommits {
+			fmt.Fprintf(&builder, "- %s (%s)\n", formatCommitLine(commit), commit.Author)
+		}
+	}
+	if len(comparison.BehindCommits) > 0 {
+		fmt.Fprintf(&builder, "\nCommits on %s not on %s:\n", comparison.Base, comparison.Head)
+		for _, commit := range comparison.BehindCommits {
+			fmt.Fprintf(&builder, "- %s (%s)\n", formatCommitLine(commit), commit.Author)
+		}
+	}
+	return builder.String()
+}
+
+// FormatCommitList creates a human-readable list of commits
+func FormatCommitList(commits []remote.Commit) string {
+	if len(commits) == 0 {
+		return "No commits found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d commits:\n", len(commits))
+	for _, commit := range commits {
+		fmt.Fprintf(&builder, "- %s (%s, %s)\n", formatCommitLine(commit), commit.Author, commit.Date)
+	}
+	return builder.String()
+}
+
+// FormatCommit creates a human-readable description of a commit with its changed files and diff
+func FormatCommit(result *CommitGetResult) string {
+	commit := result.Commit
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Commit %s\n", commit.SHA)
+	fmt.Fprintf(&builder, "Author: %s <%s>\n", commit.Author, commit.AuthorEmail)
+	if commit.Date != "" {
+		fmt.Fprintf(&builder, "Date: %s\n", commit.Date)
+	}
+	fmt.Fprintf(&builder, "\n%s\n", strings.TrimRight(commit.Message, "\n"))
+	if commit.Stats != nil {
+		fmt.Fprintf(&builder, "\n%d files changed, %d additions, %d deletions\n", len(commit.Files), commit.Stats.Additions, commit.Stats.Deletions)
+	}
+	for _, file := range commit.Files {
+		fmt.Fprintf(&builder, "- %s (%s)\n", file.Filename, file.Status)
+	}
+	if result.Diff != "" {
+		fmt.Fprintf(&builder, "\n%s", result.Diff)
+	}
+	return builder.String()
+}
+
+// FormatReleaseList creates a human-readable list of releases
+func FormatReleaseList(releases []remote.Release) string {
+	if len(releases) == 0 {
+		return "No releases found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d releases:\n", len(releases))
+	for _, release := range releases {
+		builder.WriteString(formatReleaseLine(release))
+	}
+	return builder.String()
+}
+
+// FormatRelease creates a human-readable description of a release with its notes and assets
+func FormatRelease(release *remote.Release) string {
+	var builder strings.Builder
+	builder.WriteString(strings.TrimPrefix(formatReleaseLine(*release), "- "))
+	if release.Author != "" {
+		fmt.Fprintf(&builder, "Author: %s\n", release.Author)
+	}
+	if release.Published != "" {
+		fmt.Fprintf(&builder, "Published: %s\n", release.Published)
+	}
+	if release.Body != "" {
+		fmt.Fprintf(&builder, "\n%s\n", strings.TrimRight(release.Body, "\n"))
+	}
+	if len(release.Assets) > 0 {
+		builder.WriteString("\nAssets:\n")
+		for _, asset := range release.Assets {
+			fmt.Fprintf(&builder, "- %s (%d bytes): %s\n", asset.Name, asset.Size, asset.DownloadURL)
+		}
+	}
+	return builder.String()
+}
+
+// formatReleaseLine formats a release with its draft and prerelease flags as a list item
+func formatReleaseLine(release remote.Release) string {
+	text := fmt.Sprintf("- %s: %s", release.TagName, release.Name)
+	if release.Draft {
+		text += " (draft)"
+	}
+	if release.Prerelease {
+		text += " (prerelease)"
+	}
+	return text + "\n"
+}
+
+// FormatTagList creates a human-readable list of tags with their commits
+func FormatTagList(tags []remote.Tag) string {
+	if len(tags) == 0 {
+		return "No tags found"
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Found %d tags:\n", len(tags))
+	for _, tag := range tags {
+		fmt.Fprintf(&builder, "- %s: %s\n", tag.Name, tag.CommitSHA)
+	}
+	return builder.String()
+}
+
+// formatCommitLine formats a commit as its abbreviated SHA and subject line
+func formatCommitLine(commit remote.Commit) string {
+	sha := commit.SHA
+	if len(sha) > 10 {
+		sha = sha[:10]
+	}
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+	return sha + " " + subject
+}
@@ -2,7 +2,10 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/kunde21/forgejo-mcp/config"
 	"github.com/kunde21/forgejo-mcp/remote"
@@ -21,8 +24,16 @@ type Server struct {
 	mcpServer          *mcp.Server
 	config             *config.Config
 	remote             remote.ClientInterface
+	clients            *ClientCache // per-token clients, set when per-request auth is enabled
 	repositoryResolver *RepositoryResolver
 	compatMode         bool
+
+	// Lifecycle state for the running transport, guarded by mu
+	mu         sync.Mutex
+	cancel     context.CancelFunc
+	httpServer *http.Server
+	stopped    bool          // Stop was called; a transport that has not started yet exits at once
+	ready      chan struct{} // closed once the transport is running
 }
 
 // New creates a new MCP server instance with default configuration.
@@ -94,25 +105,47 @@ func NewFromConfigWithDebugAndCompat(cfg *config.Config, debug, compat bool) (*S
 		}
 	}
 
-	// Create appropriate client based on type
-	var client remote.ClientInterface
-	var err error
+	// Create appropriate client factory based on type
+	var factory ClientFactory
 	switch cfg.ClientType {
 	case "forgejo":
-		client, err = forgejo.NewForgejoClient(cfg.RemoteURL, cfg.AuthToken)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create Forgejo client: %w", err)
+		factory = func(token string) (remote.ClientInterface, error) {
+			client, err := forgejo.NewForgejoClient(cfg.RemoteURL, token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Forgejo client: %w", err)
+			}
+			return client, nil
 		}
 	case "gitea":
-		client, err = gitea.NewGiteaClient(cfg.RemoteURL, cfg.AuthToken)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create Gitea client: %w", err)
+		factory = func(token string) (remote.ClientInterface, error) {
+			client, err := gitea.NewGiteaClient(cfg.RemoteURL, token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Gitea client: %w", err)
+			}
+			return client, nil
 		}
 	default:
 		return nil, fmt.Errorf("unsupported client type: %s", cfg.ClientType)
 	}
 
-	return NewFromServiceWithDebugAndCompat(client, cfg, debug, compat)
+	// The default client is optional with per-request auth: calls without a token are rejected
+	var client remote.ClientInterface
+	if cfg.AuthToken != "" || !cfg.PerRequestAuth {
+		var err error
+		client, err = factory(cfg.AuthToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := newServer(client, cfg, debug, compat)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PerRequestAuth {
+		s.clients = NewClientCache(cfg.ClientCacheSize, factory)
+	}
+	return s, nil
 }
 
 // NewFromService creates a new MCP server instance with the provided service.
@@ -134,6 +167,12 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 	if service == nil {
 		return nil, fmt.Errorf("service cannot be nil")
 	}
+	return newServer(service, cfg, debug, compat)
+}
+
+// newServer creates the server and registers its tools. service may be nil
+// when per-request authentication supplies every client.
+func newServer(service remote.ClientInterface, cfg *config.Config, debug, compat bool) (*Server, error) {
 	if cfg == nil {
 		cfg = &config.Config{}
 	}
@@ -143,6 +182,7 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		remote:             service,
 		repositoryResolver: NewRepositoryResolver(),
 		compatMode:         compat,
+		ready:              make(chan struct{}),
 	}
 	mcpServer := mcp.NewServer(&mcp.Implementation{
 		Name:    "forgejo-mcp",
@@ -167,6 +207,27 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		OutputSchema: generateOutputSchema[IssueList](),
 	}, s.handleIssueList)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "search_issues",
+		Description:  "Search issues and pull requests across all repositories visible to the authenticated user",
+		InputSchema:  generateInputSchema[SearchIssuesArgs](),
+		OutputSchema: generateOutputSchema[IssueList](),
+	}, s.handleSearchIssues)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "issue_fetch",
+		Description:  "Fetch detailed information about a single issue from a Forgejo/Gitea repository",
+		InputSchema:  generateInputSchema[IssueFetchArgs](),
+		OutputSchema: generateOutputSchema[IssueFetchResult](),
+	}, s.handleIssueFetch)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "issue_timeline",
+		Description:  "List the comments and events of an issue or pull request in chronological order",
+		InputSchema:  generateInputSchema[IssueTimelineArgs](),
+		OutputSchema: generateOutputSchema[IssueTimelineResult](),
+	}, s.handleIssueTimeline)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:         "issue_create",
 		Description:  "Create a new issue on a Forgejo/Gitea repository",
@@ -202,6 +263,13 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		OutputSchema: generateOutputSchema[CommentEditResult](),
 	}, s.handleIssueCommentEdit)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "issue_comment_delete",
+		Description:  "Delete a comment from a Forgejo/Gitea repository issue; comments by other users are refused unless allowed in the configuration",
+		InputSchema:  generateInputSchema[IssueCommentDeleteArgs](),
+		OutputSchema: generateOutputSchema[CommentDeleteResult](),
+	}, s.handleIssueCommentDelete)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:         "pr_list",
 		Description:  "List pull requests from a Forgejo/Gitea repository with pagination and state filtering",
@@ -237,6 +305,13 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		OutputSchema: generateOutputSchema[PullRequestCommentEditResult](),
 	}, s.handlePullRequestCommentEdit)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_comment_delete",
+		Description:  "Delete a comment from a Forgejo/Gitea repository pull request; comments by other users are refused unless allowed in the configuration",
+		InputSchema:  generateInputSchema[PullRequestCommentDeleteArgs](),
+		OutputSchema: generateOutputSchema[CommentDeleteResult](),
+	}, s.handlePullRequestCommentDelete)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:         "pr_create",
 		Description:  "Create a new pull request in a Forgejo/Gitea repository",
@@ -251,6 +326,181 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		OutputSchema: generateOutputSchema[PullRequestFetchResult](),
 	}, s.handlePullRequestFetch)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_merge",
+		Description:  "Merge a pull request in a Forgejo/Gitea repository, or schedule it to merge when checks succeed",
+		InputSchema:  generateInputSchema[PullRequestMergeArgs](),
+		OutputSchema: generateOutputSchema[PullRequestMergeResult](),
+	}, s.handlePullRequestMerge)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_review_create",
+		Description:  "Submit a review (approve, request changes, or comment) with optional inline comments on a Forgejo/Gitea pull request",
+		InputSchema:  generateInputSchema[PullRequestReviewCreateArgs](),
+		OutputSchema: generateOutputSchema[PullRequestReviewCreateResult](),
+	}, s.handlePullRequestReviewCreate)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_review_list",
+		Description:  "List reviews on a Forgejo/Gitea pull request with pagination support",
+		InputSchema:  generateInputSchema[PullRequestReviewListArgs](),
+		OutputSchema: generateOutputSchema[PullRequestReviewList](),
+	}, s.handlePullRequestReviewList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_review_comments_list",
+		Description:  "List inline comments of a review on a Forgejo/Gitea pull request",
+		InputSchema:  generateInputSchema[PullRequestReviewCommentsListArgs](),
+		OutputSchema: generateOutputSchema[PullRequestReviewCommentList](),
+	}, s.handlePullRequestReviewCommentsList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_reviewer_request",
+		Description:  "Request reviews on a Forgejo/Gitea pull request from users or teams",
+		InputSchema:  generateInputSchema[PullRequestReviewersArgs](),
+		OutputSchema: generateOutputSchema[PullRequestReviewersResult](),
+	}, s.handlePullRequestReviewerRequest)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_reviewer_remove",
+		Description:  "Remove pending review requests for users or teams from a Forgejo/Gitea pull request",
+		InputSchema:  generateInputSchema[PullRequestReviewersArgs](),
+		OutputSchema: generateOutputSchema[PullRequestReviewersResult](),
+	}, s.handlePullRequestReviewerRemove)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_diff",
+		Description:  "Fetch the unified diff of a Forgejo/Gitea pull request, optionally limited to given paths, paginated by file with per-file truncation",
+		InputSchema:  generateInputSchema[PullRequestDiffArgs](),
+		OutputSchema: generateOutputSchema[PullRequestDiffResult](),
+	}, s.handlePullRequestDiff)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "pr_files",
+		Description:  "List files changed by a Forgejo/Gitea pull request with status, additions, and deletions",
+		InputSchema:  generateInputSchema[PullRequestFilesArgs](),
+		OutputSchema: generateOutputSchema[PullRequestFileList](),
+	}, s.handlePullRequestFiles)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "commit_status",
+		Description:  "Get the combined CI status and individual check contexts for a ref or pull request head in a Forgejo/Gitea repository",
+		InputSchema:  generateInputSchema[CommitStatusArgs](),
+		OutputSchema: generateOutputSchema[CommitStatusResult](),
+	}, s.handleCommitStatus)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "action_run_list",
+		Description:  "List Forgejo Actions workflow runs for a repository, optionally filtered by branch, commit, status, or event",
+		InputSchema:  generateInputSchema[ActionRunListArgs](),
+		OutputSchema: generateOutputSchema[ActionRunList](),
+	}, s.handleActionRunList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "action_job_list",
+		Description:  "List the jobs of a Forgejo Actions workflow run",
+		InputSchema:  generateInputSchema[ActionJobListArgs](),
+		OutputSchema: generateOutputSchema[ActionJobList](),
+	}, s.handleActionJobList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "action_job_log",
+		Description:  "Fetch the log of a Forgejo Actions job with optional regular expression filtering and tail limit",
+		InputSchema:  generateInputSchema[ActionJobLogArgs](),
+		OutputSchema: generateOutputSchema[ActionJobLogResult](),
+	}, s.handleActionJobLog)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "label_list",
+		Description:  "List the labels defined in a repository",
+		InputSchema:  generateInputSchema[LabelListArgs](),
+		OutputSchema: generateOutputSchema[LabelList](),
+	}, s.handleLabelList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "label_create",
+		Description:  "Create a new label in a repository",
+		InputSchema:  generateInputSchema[LabelCreateArgs](),
+		OutputSchema: generateOutputSchema[LabelResult](),
+	}, s.handleLabelCreate)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "label_edit",
+		Description:  "Rename a repository label or change its color or description",
+		InputSchema:  generateInputSchema[LabelEditArgs](),
+		OutputSchema: generateOutputSchema[LabelResult](),
+	}, s.handleLabelEdit)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "issue_label_add",
+		Description:  "Add labels by name to an issue or pull request",
+		InputSchema:  generateInputSchema[IssueLabelsArgs](),
+		OutputSchema: generateOutputSchema[IssueLabelsResult](),
+	}, s.handleIssueLabelAdd)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "issue_label_remove",
+		Description:  "Remove labels by name from an issue or pull request",
+		InputSchema:  generateInputSchema[IssueLabelsArgs](),
+		OutputSchema: generateOutputSchema[IssueLabelsResult](),
+	}, s.handleIssueLabelRemove)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "milestone_list",
+		Description:  "List the milestones of a repository filtered by state",
+		InputSchema:  generateInputSchema[MilestoneListArgs](),
+		OutputSchema: generateOutputSchema[MilestoneList](),
+	}, s.handleMilestoneList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "milestone_create",
+		Description:  "Create a new milestone in a repository with optional description and due date",
+		InputSchema:  generateInputSchema[MilestoneCreateArgs](),
+		OutputSchema: generateOutputSchema[MilestoneResult](),
+	}, s.handleMilestoneCreate)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "milestone_edit",
+		Description:  "Change the title, description, due date, or state of a milestone identified by title",
+		InputSchema:  generateInputSchema[MilestoneEditArgs](),
+		OutputSchema: generateOutputSchema[MilestoneResult](),
+	}, s.handleMilestoneEdit)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "reaction_list",
+		Description:  "List the emoji reactions on an issue or pull request, or on one of its comments, grouped by emoji",
+		InputSchema:  generateInputSchema[ReactionListArgs](),
+		OutputSchema: generateOutputSchema[ReactionListResult](),
+	}, s.handleReactionList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "reaction_add",
+		Description:  "Add an emoji reaction to an issue or pull request, or to one of its comments",
+		InputSchema:  generateInputSchema[ReactionArgs](),
+		OutputSchema: generateOutputSchema[ReactionResult](),
+	}, s.handleReactionAdd)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "reaction_remove",
+		Description:  "Remove your emoji reaction from an issue or pull request, or from one of its comments",
+		InputSchema:  generateInputSchema[ReactionArgs](),
+		OutputSchema: generateOutputSchema[ReactionResult](),
+	}, s.handleReactionRemove)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "attachment_list",
+		Description:  "List the files attached to an issue or pull request, or to one of its comments",
+		InputSchema:  generateInputSchema[AttachmentListArgs](),
+		OutputSchema: generateOutputSchema[AttachmentListResult](),
+	}, s.handleAttachmentList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "attachment_get",
+		Description:  "Download a file attached to an issue, pull request, or comment, returned as image content for images and as resource content for logs and other files",
+		InputSchema:  generateInputSchema[AttachmentGetArgs](),
+		OutputSchema: generateOutputSchema[AttachmentGetResult](),
+	}, s.handleAttachmentGet)
+
 	mcp.AddTool(mcpServer, &mcp.Tool{
 		Name:         "notification_list",
 		Description:  "List notifications from a Git repository with optional filtering",
@@ -258,31 +508,213 @@ func NewFromServiceWithDebugAndCompat(service remote.ClientInterface, cfg *confi
 		OutputSchema: generateOutputSchema[NotificationList](),
 	}, s.handleNotificationList)
 
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "notification_digest",
+		Description:  "Summarize unread notifications by repository and reason (review requested, mention, assigned, failing CI) as a prioritized work list with latest comment excerpts",
+		InputSchema:  generateInputSchema[NotificationDigestArgs](),
+		OutputSchema: generateOutputSchema[NotificationDigestResult](),
+	}, s.handleNotificationDigest)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "notification_mark",
+		Description:  "Mark a notification thread as read, unread, or pinned, or mark all unread notifications of a repository as read",
+		InputSchema:  generateInputSchema[NotificationMarkArgs](),
+		OutputSchema: generateOutputSchema[NotificationMarkResult](),
+	}, s.handleNotificationMark)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "notification_subscribe",
+		Description:  "Subscribe to the notifications of an issue or pull request thread",
+		InputSchema:  generateInputSchema[NotificationSubscriptionArgs](),
+		OutputSchema: generateOutputSchema[NotificationSubscriptionResult](),
+	}, s.handleNotificationSubscribe)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "notification_unsubscribe",
+		Description:  "Unsubscribe from the notifications of an issue or pull request thread",
+		InputSchema:  generateInputSchema[NotificationSubscriptionArgs](),
+		OutputSchema: generateOutputSchema[NotificationSubscriptionResult](),
+	}, s.handleNotificationUnsubscribe)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "repo_file_get",
+		Description:  "Read a file of a repository at a branch, tag, or commit, optionally limited to a range of lines; binary files return only metadata",
+		InputSchema:  generateInputSchema[RepoFileGetArgs](),
+		OutputSchema: generateOutputSchema[RepoFileGetResult](),
+	}, s.handleRepoFileGet)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "repo_tree_list",
+		Description:  "List the files and directories of a repository at a branch, tag, or commit, optionally recursive, with sizes and SHAs",
+		InputSchema:  generateInputSchema[RepoTreeListArgs](),
+		OutputSchema: generateOutputSchema[RepoTreeListResult](),
+	}, s.handleRepoTreeList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "repo_file_write",
+		Description:  "Create or update a file on a branch in one commit without a local clone; pass the sha from repo_file_get to update so concurrent edits are rejected",
+		InputSchema:  generateInputSchema[RepoFileWriteArgs](),
+		OutputSchema: generateOutputSchema[RepoFileChangeResult](),
+	}, s.handleRepoFileWrite)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "repo_file_delete",
+		Description:  "Delete a file from a branch in one commit without a local clone; the sha from repo_file_get must match the current file",
+		InputSchema:  generateInputSchema[RepoFileDeleteArgs](),
+		OutputSchema: generateOutputSchema[RepoFileChangeResult](),
+	}, s.handleRepoFileDelete)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "branch_list",
+		Description:  "List the branches of a remote repository with their last commit and protection status",
+		InputSchema:  generateInputSchema[BranchListArgs](),
+		OutputSchema: generateOutputSchema[BranchList](),
+	}, s.handleBranchList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "branch_create",
+		Description:  "Create a branch on the remote repository from a branch, tag or commit",
+		InputSchema:  generateInputSchema[BranchCreateArgs](),
+		OutputSchema: generateOutputSchema[BranchResult](),
+	}, s.handleBranchCreate)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "branch_delete",
+		Description:  "Delete a branch from the remote repository",
+		InputSchema:  generateInputSchema[BranchDeleteArgs](),
+		OutputSchema: generateOutputSchema[BranchDeleteResult](),
+	}, s.handleBranchDelete)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "branch_compare",
+		Description:  "Compare two branches on the remote, returning how many commits head is ahead and behind base and the differing commits",
+		InputSchema:  generateInputSchema[BranchCompareArgs](),
+		OutputSchema: generateOutputSchema[BranchCompareResult](),
+	}, s.handleBranchCompare)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "commit_list",
+		Description:  "List the commits of a repository by branch, path, author and date range, or the commits of a pull request",
+		InputSchema:  generateInputSchema[CommitListArgs](),
+		OutputSchema: generateOutputSchema[CommitList](),
+	}, s.handleCommitList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "commit_get",
+		Description:  "Get a single commit with its message, author, stats and changed files, optionally with its diff",
+		InputSchema:  generateInputSchema[CommitGetArgs](),
+		OutputSchema: generateOutputSchema[CommitGetResult](),
+	}, s.handleCommitGet)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "release_list",
+		Description:  "List the releases of a repository with their draft and prerelease flags and assets",
+		InputSchema:  generateInputSchema[ReleaseListArgs](),
+		OutputSchema: generateOutputSchema[ReleaseList](),
+	}, s.handleReleaseList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "release_get",
+		Description:  "Get the release of a tag with its notes and assets",
+		InputSchema:  generateInputSchema[ReleaseGetArgs](),
+		OutputSchema: generateOutputSchema[ReleaseResult](),
+	}, s.handleReleaseGet)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "release_create",
+		Description:  "Create a release for a tag, creating the tag from a target branch or commit if needed, with notes, draft and prerelease flags and uploaded assets",
+		InputSchema:  generateInputSchema[ReleaseCreateArgs](),
+		OutputSchema: generateOutputSchema[ReleaseResult](),
+	}, s.handleReleaseCreate)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "release_edit",
+		Description:  "Edit the tag, title, notes, or draft and prerelease flags of a release, or upload additional assets",
+		InputSchema:  generateInputSchema[ReleaseEditArgs](),
+		OutputSchema: generateOutputSchema[ReleaseResult](),
+	}, s.handleReleaseEdit)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "tag_list",
+		Description:  "List the git tags of a repository with their commits",
+		InputSchema:  generateInputSchema[TagListArgs](),
+		OutputSchema: generateOutputSchema[TagList](),
+	}, s.handleTagList)
+
+	mcp.AddTool(mcpServer, &mcp.Tool{
+		Name:         "tag_create",
+		Description:  "Create a lightweight or annotated git tag at a branch or commit",
+		InputSchema:  generateInputSchema[TagCreateArgs](),
+		OutputSchema: generateOutputSchema[TagResult](),
+	}, s.handleTagCreate)
+
 	s.mcpServer = mcpServer
 	return s, nil
 }
 
 // Start starts the MCP server using stdio transport.
-// The server will listen for MCP protocol messages on stdin/stdout.
+// The server will listen for MCP protocol messages on stdin/stdout
+// until the input stream closes or Stop is called.
 //
 // Migration Note: Updated to use the official SDK's Run method with StdioTransport
 // instead of the previous SDK's server start pattern.
 func (s *Server) Start() error {
-	return s.mcpServer.Run(context.Background(), &mcp.StdioTransport{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.cancel = cancel
+	close(s.ready)
+	s.mu.Unlock()
+
+	if err := s.mcpServer.Run(ctx, &mcp.StdioTransport{}); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
 }
 
 // Stop stops the MCP server gracefully.
-// Note: For stdio transport, the server runs until the process ends,
-// so this method primarily handles cleanup of resources.
-//
-// Migration Note: The official SDK handles server lifecycle differently;
-// stdio servers run until process termination rather than explicit stopping.
+// For the HTTP transports the listener is closed first and open event streams
+// are ended, while other in-flight requests are given up to ShutdownTimeout to
+// complete; the server lifecycle context is cancelled only afterwards, ending
+// any remaining tool calls. For the stdio transport the active session context
+// is cancelled immediately. A transport that is still starting when Stop is
+// called exits as soon as it is ready, and the server does not start again.
 func (s *Server) Stop() error {
-	// MCP server doesn't have a direct stop method for stdio
-	// It runs until the process ends
+	s.mu.Lock()
+	cancel, httpServer := s.cancel, s.httpServer
+	s.cancel, s.httpServer = nil, nil
+	s.stopped = true
+	s.mu.Unlock()
+
+	if cancel != nil {
+		defer cancel()
+	}
+	if httpServer == nil {
+		return nil
+	}
+
+	ctx, done := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer done()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		if cancel != nil {
+			cancel()
+		}
+		httpServer.Close()
+		return fmt.Errorf("failed to shut down http server: %w", err)
+	}
 	return nil
 }
 
+// Ready returns a channel that is closed once the transport started through
+// Start or StartWithTransport is running; for the HTTP transports, once the
+// listener accepts connections.
+func (s *Server) Ready() <-chan struct{} { return s.ready }
+
 // MCPServer returns the underlying MCP server instance.
 // This provides access to the official SDK server for advanced use cases.
 //
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kunde21/forgejo-mcp/remote"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TagListArgs represents the arguments for listing repository tags
+type TagListArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Limit      int    `json:"limit,omitzero"`      // Maximum number of tags to return
+	Offset     int    `json:"offset,omitzero"`     // Number of tags to skip
+}
+
+// TagList represents the result data for the tag_list tool
+type TagList struct {
+	Tags   []remote.Tag `json:"tags"`
+	Total  int          `json:"total"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+// TagCreateArgs represents the arguments for creating a tag
+type TagCreateArgs struct {
+	Repository string `json:"repository,omitzero"` // Repository path in "owner/repo" format
+	Directory  string `json:"directory,omitzero"`  // Local directory path for automatic resolution
+	Name       string `json:"name"`                // Name of the new tag
+	Target     string `json:"target,omitzero"`     // Branch or commit SHA to tag (default branch if not provided)
+	Message    string `json:"message,omitzero"`    // Annotation message, creating an annotated tag
+}
+
+// TagResult represents the result data for the tag_create tool
+type TagResult struct {
+	Tag *remote.Tag `json:"tag,omitempty"`
+}
+
+// handleTagList handles the "tag_list" tool request.
+// It lists the git tags of a repository, newest first.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - limit: Maximum number of tags to return (1-100, default 15)
+//   - offset: Number of tags to skip for pagination (default 0)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The tags with their commits and pagination metadata
+//   - Error: Validation errors or API failures
+func (s *Server) handleTagList(ctx context.Context, request *mcp.CallToolRequest, args TagListArgs) (*mcp.CallToolResult, *TagList, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Set default limit if not provided
+	if args.Limit == 0 {
+		args.Limit = 15
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Limit, v.Min(1), v.Max(100)),
+		v.Field(&args.Offset, v.Min(0)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	tags, err := client.ListTags(ctx, repository, args.Limit, args.Offset)
+	if err != nil {
+		return TextErrorf("Failed to list tags: %v", err), nil, nil
+	}
+
+	var responseText string
+	if s.compatMode {
+		responseText = FormatTagList(tags.Tags)
+	} else {
+		responseText = fmt.Sprintf("Found %d tags", len(tags.Tags))
+	}
+
+	return TextResult(responseText), &TagList{
+		Tags:   tags.Tags,
+		Total:  tags.Total,
+		Limit:  tags.Limit,
+		Offset: tags.Offset,
+	}, nil
+}
+
+// handleTagCreate handles the "tag_create" tool request.
+// It creates a git tag on the remote at a branch or commit.
+//
+// Parameters:
+//   - repository: The repository path in "owner/repo" format
+//   - directory: Local directory path containing a git repository for automatic resolution
+//   - name: The name of the new tag (required)
+//   - target: Branch or commit SHA to tag (optional, defaults to the default branch)
+//   - message: Annotation message; without it a lightweight tag is created (optional)
+//
+// Note: At least one of repository or directory must be provided. If both are provided,
+// directory takes precedence for automatic repository resolution.
+//
+// Returns:
+//   - Success: The created tag with its commit
+//   - Error: Validation errors, existing tags, or API failures
+func (s *Server) handleTagCreate(ctx context.Context, request *mcp.CallToolRequest, args TagCreateArgs) (*mcp.CallToolResult, *TagResult, error) {
+	// Validate context
+	if ctx == nil {
+		return TextError("Context is required"), nil, nil
+	}
+
+	// Validate input arguments using ozzo-validation
+	if err := v.ValidateStruct(&args,
+		v.Field(&args.Repository, v.When(args.Directory == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.Match(repoReg).Error("repository must be in format 'owner/repo'"),
+		)),
+		v.Field(&args.Directory, v.When(args.Repository == "",
+			v.Required.Error("at least one of directory or repository must be provided"),
+			v.By(func(any) error {
+				if !filepath.IsAbs(args.Directory) {
+					return v.NewError("abs_dir", "directory must be an absolute path")
+				}
+				stat, err := os.Stat(args.Directory)
+				if err != nil {
+					return v.NewError("abs_dir", "invalid directory")
+				}
+				if !stat.IsDir() {
+					return v.NewError("abs_dir", "does not exist")
+				}
+				return nil
+			}),
+		)),
+		v.Field(&args.Name, v.Required.Error("tag name is required"), v.Length(1, 100)),
+	); err != nil {
+		return TextErrorf("Invalid request: %v", err), nil, nil
+	}
+
+	repository := args.Repository
+	if args.Directory != "" {
+		// Resolve directory to repository (takes precedence if both provided)
+		resolution, err := s.repositoryResolver.ResolveRepository(args.Directory)
+		if err != nil {
+			return TextErrorf("Failed to resolve directory: %v", err), nil, nil
+		}
+		repository = resolution.Repository
+	}
+
+	// Get remote client
+	client, err := s.getRemoteClient(ctx, request)
+	if err != nil {
+		return TextErrorf("Failed to get remote client: %v", err), nil, nil
+	}
+
+	tag, err := client.CreateTag(ctx, repository, args.Name, args.Target, args.Message)
+	if err != nil {
+		return TextErrorf("Failed to create tag: %v", err), nil, nil
+	}
+
+	return TextResultf("Created tag %s at %s", tag.Name, tag.CommitSHA), &TagResult{Tag: tag}, nil
+}
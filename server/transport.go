@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Supported transport modes for serving MCP sessions
+const (
+	TransportStdio = "stdio" // MCP messages over stdin/stdout (default)
+	TransportHTTP  = "http"  // Streamable HTTP transport served at HTTPEndpoint
+	TransportSSE   = "sse"   // Legacy HTTP+SSE transport served at SSEEndpoint
+)
+
+// Endpoint paths used by the network transports
+const (
+	HTTPEndpoint = "/mcp"
+	SSEEndpoint  = "/sse"
+)
+
+// ShutdownTimeout bounds how long Stop waits for in-flight HTTP requests to finish
+const ShutdownTimeout = 10 * time.Second
+
+// StartWithTransport starts the MCP server using the requested transport mode.
+// For the "http" and "sse" transports the server listens on addr (host:port);
+// addr is ignored for the "stdio" transport. The call blocks until the server
+// stops, and returns nil when it was stopped through Stop.
+func (s *Server) StartWithTransport(transport, addr string) error {
+	switch transport {
+	case "", TransportStdio:
+		return s.Start()
+	case TransportHTTP:
+		mux := http.NewServeMux()
+		mux.Handle(HTTPEndpoint, s.StreamableHTTPHandler())
+		return s.serveHTTP(addr, mux)
+	case TransportSSE:
+		mux := http.NewServeMux()
+		mux.Handle(SSEEndpoint, s.SSEHandler())
+		return s.serveHTTP(addr, mux)
+	default:
+		return fmt.Errorf("unsupported transport: %s", transport)
+	}
+}
+
+// StreamableHTTPHandler returns an http.Handler serving MCP sessions over the
+// streamable HTTP transport. Every session shares this server's tool set.
+// Authorization headers are passed through to tool calls for per-request auth.
+func (s *Server) StreamableHTTPHandler() http.Handler {
+	return AuthTokenMiddleware(mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return s.mcpServer
+	}, nil))
+}
+
+// SSEHandler returns an http.Handler serving MCP sessions over the legacy
+// HTTP+SSE transport (MCP spec 2024-11-05). The Authorization header of the
+// stream request binds a token to the whole session.
+func (s *Server) SSEHandler() http.Handler {
+	return AuthTokenMiddleware(mcp.NewSSEHandler(func(*http.Request) *mcp.Server {
+		return s.mcpServer
+	}))
+}
+
+// serveHTTP listens on addr and serves handler until Stop is called.
+// Request contexts derive from the server lifecycle context, which Stop
+// cancels once in-flight requests have finished or ShutdownTimeout expires.
+// The event streams held open by GET requests never go idle, so they are
+// ended as soon as the shutdown starts rather than holding it open.
+func (s *Server) serveHTTP(addr string, handler http.Handler) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	streamCtx, endStreams := context.WithCancel(ctx)
+
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           endStreamsOnShutdown(streamCtx, handler),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	httpServer.RegisterOnShutdown(endStreams)
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to serve %s: %w", addr, err)
+	}
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		cancel()
+		listener.Close()
+		return nil
+	}
+	s.cancel = cancel
+	s.httpServer = httpServer
+	close(s.ready)
+	s.mu.Unlock()
+
+	// Serve returns as soon as the shutdown starts; Stop cancels the lifecycle
+	// context once in-flight requests have finished
+	if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		cancel()
+		return fmt.Errorf("failed to serve %s: %w", addr, err)
+	}
+	return nil
+}
+
+// endStreamsOnShutdown cancels the context of GET requests, which carry the SSE and
+// streamable HTTP event streams, once streamCtx is done. Other requests keep their context
+// so in-flight tool calls can finish during the shutdown.
+func endStreamsOnShutdown(streamCtx context.Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			defer context.AfterFunc(streamCtx, cancel)()
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,229 @@
+package server_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kunde21/forgejo-mcp/config"
+	"github.com/kunde21/forgejo-mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newTransportTestServer creates a server backed by a stub remote that only answers the version endpoint
+func newTransportTestServer(t *testing.T) *server.Server {
+	t.Helper()
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/version" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"version": "1.20.0"}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(remote.Close)
+
+	s, err := server.NewFromConfig(&config.Config{
+		RemoteURL:  remote.URL,
+		AuthToken:  "test-token",
+		ClientType: "gitea",
+	})
+	if err != nil {
+		t.Fatalf("NewFromConfig failed: %v", err)
+	}
+	return s
+}
+
+func TestStreamableHTTPHandler_ListTools(t *testing.T) {
+	s := newTransportTestServer(t)
+	httpServer := httptest.NewServer(s.StreamableHTTPHandler())
+	t.Cleanup(httpServer.Close)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(t.Context(), mcp.NewStreamableClientTransport(httpServer.URL, nil), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect over streamable HTTP: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	tools, err := session.ListTools(t.Context(), &mcp.ListToolsParams{})
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	if len(tools.Tools) == 0 {
+		t.Error("Expected tools to be listed over streamable HTTP")
+	}
+}
+
+func TestSSEHandler_ListTools(t *testing.T) {
+	s := newTransportTestServer(t)
+	httpServer := httptest.NewServer(s.SSEHandler())
+	t.Cleanup(httpServer.Close)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(t.Context(), mcp.NewSSEClientTransport(httpServer.URL, nil), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect over SSE: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+
+	tools, err := session.ListTools(t.Context(), &mcp.ListToolsParams{})
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	if len(tools.Tools) == 0 {
+		t.Error("Expected tools to be listed over SSE")
+	}
+}
+
+func TestStartWithTransport_StopHTTP(t *testing.T) {
+	s := newTransportTestServer(t)
+
+	// Reserve a free port for the server to bind
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to reserve port: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.StartWithTransport(server.TransportHTTP, addr)
+	}()
+
+	select {
+	case <-s.Ready():
+	case err := <-errChan:
+		t.Fatalf("Server did not start listening: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Server did not start listening")
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Errorf("Expected nil error after Stop, got: %v", err)
+		}
+	case <-time.After(server.ShutdownTimeout):
+		t.Fatal("Server did not stop")
+	}
+}
+
+func TestStartWithTransport_StopWithOpenStream(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transport string
+		endpoint  string
+		client    func(url string) mcp.Transport
+	}{
+		{
+			name:      "sse",
+			transport: server.TransportSSE,
+			endpoint:  server.SSEEndpoint,
+			client:    func(url string) mcp.Transport { return mcp.NewSSEClientTransport(url, nil) },
+		},
+		{
+			name:      "streamable http",
+			transport: server.TransportHTTP,
+			endpoint:  server.HTTPEndpoint,
+			client:    func(url string) mcp.Transport { return mcp.NewStreamableClientTransport(url, nil) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTransportTestServer(t)
+
+			// Reserve a free port for the server to bind
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("Failed to reserve port: %v", err)
+			}
+			addr := listener.Addr().String()
+			listener.Close()
+
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- s.StartWithTransport(tc.transport, addr)
+			}()
+			select {
+			case <-s.Ready():
+			case err := <-errChan:
+				t.Fatalf("Server did not start listening: %v", err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("Server did not start listening")
+			}
+
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+			session, err := client.Connect(t.Context(), tc.client("http://"+addr+tc.endpoint), nil)
+			if err != nil {
+				t.Fatalf("Failed to connect: %v", err)
+			}
+			t.Cleanup(func() { session.Close() })
+			if _, err := session.ListTools(t.Context(), &mcp.ListToolsParams{}); err != nil {
+				t.Fatalf("Failed to list tools: %v", err)
+			}
+
+			start := time.Now()
+			if err := s.Stop(); err != nil {
+				t.Fatalf("Stop failed: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Stop took %v with a connected client, expected it to end the stream at once", elapsed)
+			}
+
+			select {
+			case err := <-errChan:
+				if err != nil {
+					t.Errorf("Expected nil error after Stop, got: %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("Server did not stop")
+			}
+		})
+	}
+}
+
+func TestStartWithTransport_StopBeforeStart(t *testing.T) {
+	s := newTransportTestServer(t)
+
+	if err := s.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.StartWithTransport(server.TransportHTTP, "127.0.0.1:0")
+	}()
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Errorf("Expected nil error for a stopped server, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Server started after Stop")
+	}
+}
+
+func TestStartWithTransport_Unsupported(t *testing.T) {
+	s := newTransportTestServer(t)
+
+	if err := s.StartWithTransport("carrier-pigeon", ""); err == nil {
+		t.Error("Expected error for unsupported transport")
+	}
+}
+
+func TestStop_NotStarted(t *testing.T) {
+	s := newTransportTestServer(t)
+
+	if err := s.Stop(); err != nil {
+		t.Errorf("Expected Stop on an idle server to succeed, got: %v", err)
+	}
+}
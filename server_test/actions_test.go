@@ -0,0 +1,438 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type actionsTestCase struct {
+	name       string
+	clientType string // FORGEJO_CLIENT_TYPE, defaults to "forgejo"
+	tool       string
+	setupMock  func(*MockGiteaServer) // Applied after the default test runs are added
+	arguments  map[string]any
+	expect     *mcp.CallToolResult
+}
+
+func addTestActionRuns(mock *MockGiteaServer) {
+	mock.AddActionRuns("testuser", "testrepo", []MockActionRun{
+		{
+			ID: 7, RunNumber: 3, Title: "Fix login", WorkflowID: "ci.yml", Status: "failure",
+			Event: "pull_request", Branch: "feature", CommitSHA: "abc123",
+			Jobs: []MockActionJob{
+				{ID: 70, Name: "build", Status: "completed", Conclusion: "success", Log: "go build ./...\nok\n"},
+				{
+					ID: 71, Name: "test", Status: "completed", Conclusion: "failure",
+					Log: "=== RUN TestA\n--- PASS: TestA\n=== RUN TestB\n--- FAIL: TestB\nmain_test.go:12: want 1, got 2\nFAIL\n",
+				},
+			},
+		},
+		{
+			ID: 6, RunNumber: 2, Title: "Release prep", WorkflowID: "ci.yml", Status: "success",
+			Event: "push", Branch: "main", CommitSHA: "def456",
+		},
+	})
+}
+
+func TestActions(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []actionsTestCase{
+		{
+			name: "list runs for branch",
+			tool: "action_run_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"branch":     "main",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 action runs"},
+				},
+				StructuredContent: map[string]any{
+					"runs": []any{
+						map[string]any{
+							"id":           float64(6),
+							"run_number":   float64(2),
+							"title":        "Release prep",
+							"workflow_id":  "ci.yml",
+							"status":       "success",
+							"event":        "push",
+							"branch":       "main",
+							"commit_sha":   "def456",
+							"trigger_user": "testuser",
+							"created":      "2025-10-01T12:00:00Z",
+							"started":      "2025-10-01T12:00:05Z",
+							"html_url":     "https://example.com/testuser/testrepo/actions/runs/2",
+						},
+					},
+					"total":  float64(1),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name: "list runs for commit",
+			tool: "action_run_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"head_sha":   "abc123",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 action runs"},
+				},
+				StructuredContent: map[string]any{
+					"runs": []any{
+						map[string]any{
+							"id":           float64(7),
+							"run_number":   float64(3),
+							"title":        "Fix login",
+							"workflow_id":  "ci.yml",
+							"status":       "failure",
+							"event":        "pull_request",
+							"branch":       "feature",
+							"commit_sha":   "abc123",
+							"trigger_user": "testuser",
+							"created":      "2025-10-01T12:00:00Z",
+							"started":      "2025-10-01T12:00:05Z",
+							"html_url":     "https://example.com/testuser/testrepo/actions/runs/3",
+						},
+					},
+					"total":  float64(1),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name: "list jobs of run",
+			tool: "action_job_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"run_id":     7,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 jobs in run 7"},
+				},
+				StructuredContent: map[string]any{
+					"jobs": []any{
+						map[string]any{
+							"id":         float64(70),
+							"run_id":     float64(7),
+							"name":       "build",
+							"status":     "completed",
+							"conclusion": "success",
+							"started":    "2025-10-01T12:00:05Z",
+							"completed":  "2025-10-01T12:02:00Z",
+						},
+						map[string]any{
+							"id":         float64(71),
+							"run_id":     float64(7),
+							"name":       "test",
+							"status":     "completed",
+							"conclusion": "failure",
+							"started":    "2025-10-01T12:00:05Z",
+							"completed":  "2025-10-01T12:02:00Z",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "error: jobs of unknown run",
+			tool: "action_job_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"run_id":     99,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list action jobs: failed to list action jobs: run 99 not found in testuser/testrepo"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "job log with grep",
+			tool: "action_job_log",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"job_id":     71,
+				"grep":       "FAIL|want",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Returned 3 of 6 log lines for job 71"},
+				},
+				StructuredContent: map[string]any{
+					"log":            "--- FAIL: TestB\nmain_test.go:12: want 1, got 2\nFAIL\n",
+					"total_lines":    float64(6),
+					"matched_lines":  float64(3),
+					"returned_lines": float64(3),
+					"truncated":      false,
+				},
+			},
+		},
+		{
+			name: "job log tail",
+			tool: "action_job_log",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"job_id":     71,
+				"tail":       2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Returned 2 of 6 log lines for job 71"},
+				},
+				StructuredContent: map[string]any{
+					"log":            "... [4 earlier lines omitted] ...\nmain_test.go:12: want 1, got 2\nFAIL\n",
+					"total_lines":    float64(6),
+					"matched_lines":  float64(6),
+					"returned_lines": float64(2),
+					"truncated":      true,
+				},
+			},
+		},
+		{
+			name: "error: invalid grep pattern",
+			tool: "action_job_log",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"job_id":     71,
+				"grep":       "(unclosed",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: grep: grep must be a valid regular expression."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:       "list runs for branch (gitea)",
+			clientType: "gitea",
+			tool:       "action_run_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"branch":     "feature",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 action runs"},
+				},
+				StructuredContent: map[string]any{
+					"runs": []any{
+						map[string]any{
+							"id":           float64(7),
+							"run_number":   float64(3),
+							"title":        "Fix login",
+							"workflow_id":  "ci.yml",
+							"status":       "failure",
+							"event":        "pull_request",
+							"branch":       "feature",
+							"commit_sha":   "abc123",
+							"trigger_user": "testuser",
+							"created":      "2025-10-01T12:00:05Z",
+							"started":      "2025-10-01T12:00:05Z",
+							"html_url":     "https://example.com/testuser/testrepo/actions/runs/3",
+						},
+					},
+					"total":  float64(1),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:       "job log (gitea)",
+			clientType: "gitea",
+			tool:       "action_job_log",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"job_id":     70,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Returned 2 of 2 log lines for job 70"},
+				},
+				StructuredContent: map[string]any{
+					"log":            "go build ./...\nok\n",
+					"total_lines":    float64(2),
+					"matched_lines":  float64(2),
+					"returned_lines": float64(2),
+					"truncated":      false,
+				},
+			},
+		},
+		{
+			name:       "error: unknown job (gitea)",
+			clientType: "gitea",
+			tool:       "action_job_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"run_id":     99,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list action jobs: failed to list action jobs: run 99 not found in testuser/testrepo"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:       "error: gitea without actions API reports unsupported",
+			clientType: "gitea",
+			tool:       "action_run_list",
+			setupMock:  (*MockGiteaServer).SetActionsUnsupported,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list action runs: failed to list action runs: operation not supported by this remote: actions runs API not available for testuser/testrepo"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:       "error: gitea without actions API reports unsupported jobs",
+			clientType: "gitea",
+			tool:       "action_job_list",
+			setupMock:  (*MockGiteaServer).SetActionsUnsupported,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"run_id":     7,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list action jobs: failed to list action jobs: operation not supported by this remote: actions API not available for testuser/testrepo"},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addTestActionRuns(mock)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			clientType := tc.clientType
+			if clientType == "" {
+				clientType = "forgejo"
+			}
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL":  mock.URL(),
+				"FORGEJO_AUTH_TOKEN":  "mock-token",
+				"FORGEJO_CLIENT_TYPE": clientType,
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+// TestActionRunList_BranchScan verifies Forgejo branch filtering pages through all runs
+// and only reports a total once every run was scanned
+func TestActionRunList_BranchScan(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+
+	// Runs on main are spread across several server pages
+	var runs []MockActionRun
+	for i := range 120 {
+		branch := "feature"
+		if i%20 == 19 {
+			branch = "main"
+		}
+		runs = append(runs, MockActionRun{ID: 1000 - i, RunNumber: 1000 - i, Title: "Run", WorkflowID: "ci.yml", Status: "success", Event: "push", Branch: branch})
+	}
+
+	testCases := []struct {
+		name      string
+		arguments map[string]any
+		wantIDs   []float64
+		wantTotal any
+	}{
+		{
+			name:      "more matches remain",
+			arguments: map[string]any{"repository": "testuser/testrepo", "branch": "main", "limit": 2},
+			wantIDs:   []float64{981, 961},
+			wantTotal: nil,
+		},
+		{
+			name:      "last matches beyond the first page",
+			arguments: map[string]any{"repository": "testuser/testrepo", "branch": "main", "limit": 4, "offset": 4},
+			wantIDs:   []float64{901, 881},
+			wantTotal: float64(6),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			mock.AddActionRuns("testuser", "testrepo", runs)
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL":  mock.URL(),
+				"FORGEJO_AUTH_TOKEN":  "mock-token",
+				"FORGEJO_CLIENT_TYPE": "forgejo",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "action_run_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call action_run_list tool: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Expected success, got error: %s", GetTextContent(result.Content))
+			}
+
+			structured := GetStructuredContent(result)
+			var ids []float64
+			listed, _ := structured["runs"].([]any)
+			for _, run := range listed {
+				ids = append(ids, run.(map[string]any)["id"].(float64))
+			}
+			if !cmp.Equal(tc.wantIDs, ids) {
+				t.Error(cmp.Diff(tc.wantIDs, ids))
+			}
+			if structured["total"] != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, structured["total"])
+			}
+		})
+	}
+}
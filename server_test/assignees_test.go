@@ -0,0 +1,323 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type assigneeTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	tool      string
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+func addAssigneeTestData(mock *MockGiteaServer) {
+	mock.AddCollaborators("testuser", "testrepo", "alice", "bob")
+	mock.AddTeamMembers("testuser", "testrepo", "carol")
+	mock.AddUsers("mallory")
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Created: "2025-09-10T09:00:00Z", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 10, Number: 5, Title: "Fix crash", State: "open", BaseRef: "main", UpdatedAt: "2025-09-12T10:30:00Z"},
+	})
+}
+
+func TestAssignees(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []assigneeTestCase{
+		{
+			name:      "create issue with assignees",
+			setupMock: addAssigneeTestData,
+			tool:      "issue_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "Slow startup",
+				"assignees":  []string{"alice", "testuser"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue created successfully. Number: 2, Title: Slow startup"},
+				},
+				StructuredContent: map[string]any{
+					"issue": map[string]any{
+						"id":        float64(2),
+						"number":    float64(2),
+						"title":     "Slow startup",
+						"state":     "open",
+						"user":      "testuser",
+						"assignees": []any{"alice", "testuser"},
+					},
+				},
+			},
+		},
+		{
+			name:      "replace issue assignees",
+			setupMock: addAssigneeTestData,
+			tool:      "issue_edit",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"assignees":    []string{"alice", "bob"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue edited successfully. Number: 1, Title: Crash on start, State: open"},
+				},
+				StructuredContent: map[string]any{
+					"issue": map[string]any{
+						"id":        float64(1),
+						"number":    float64(1),
+						"title":     "Crash on start",
+						"state":     "open",
+						"user":      "testuser",
+						"created":   "2025-09-10T09:00:00Z",
+						"updated":   "2025-10-06T12:00:00Z",
+						"assignees": []any{"alice", "bob"},
+					},
+				},
+			},
+		},
+		{
+			name:      "assign team member",
+			setupMock: addAssigneeTestData,
+			tool:      "issue_edit",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"assignees":    []string{"carol"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue edited successfully. Number: 1, Title: Crash on start, State: open"},
+				},
+				StructuredContent: map[string]any{
+					"issue": map[string]any{
+						"id":        float64(1),
+						"number":    float64(1),
+						"title":     "Crash on start",
+						"state":     "open",
+						"user":      "testuser",
+						"created":   "2025-09-10T09:00:00Z",
+						"updated":   "2025-10-06T12:00:00Z",
+						"assignees": []any{"carol"},
+					},
+				},
+			},
+		},
+		{
+			name:      "error: unknown and outsider assignees",
+			setupMock: addAssigneeTestData,
+			tool:      "issue_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "Slow startup",
+				"assignees":  []string{"ghost", "alice", "mallory"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to create issue: failed to create issue: users not found: ghost; users without write access to testuser/testrepo: mallory"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: unknown assignee",
+			setupMock: addAssigneeTestData,
+			tool:      "issue_edit",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"assignees":    []string{"alice", "ghost"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to edit issue: failed to edit issue: users not found: ghost"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: assignee is not a collaborator",
+			setupMock: addAssigneeTestData,
+			tool:      "pr_edit",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"assignees":           []string{"mallory"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to edit pull request: failed to edit pull request: users without write access to testuser/testrepo: mallory"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: assignees and clear_assignees",
+			tool: "issue_edit",
+			arguments: map[string]any{
+				"repository":      "testuser/testrepo",
+				"issue_number":    1,
+				"assignees":       []string{"alice"},
+				"clear_assignees": true,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: assignees: only one of assignees or clear_assignees may be set."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid assignee name",
+			tool: "pr_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"head":       "feature",
+				"base":       "main",
+				"title":      "Add feature",
+				"assignees":  []string{"alice smith"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: assignees: (0: assignee must be a valid username.)."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: create pull request with unknown assignee",
+			setupMock: addAssigneeTestData,
+			tool:      "pr_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"head":       "feature",
+				"base":       "main",
+				"title":      "Add feature",
+				"assignee":   "ghost",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to create pull request in 'testuser/testrepo' from 'feature' to 'main': failed to create pull request: users not found: ghost"},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+func TestPRCreateAssignees(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	addAssigneeTestData(mock)
+
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name: "pr_create",
+		Arguments: map[string]any{
+			"repository": "testuser/testrepo",
+			"head":       "feature",
+			"base":       "main",
+			"title":      "Add feature",
+			"assignee":   "alice",
+			"assignees":  []string{"bob", "alice"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call pr_create tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("pr_create failed: %s", GetTextContent(result.Content))
+	}
+
+	pr, _ := GetStructuredContent(result)["pull_request"].(map[string]any)
+	number := int(pr["number"].(float64))
+	want := []string{"bob", "alice"}
+	if got := mock.Assignees("testuser", "testrepo", number); !cmp.Equal(want, got) {
+		t.Error(cmp.Diff(want, got))
+	}
+}
+
+func TestPREditClearAssignees(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	addAssigneeTestData(mock)
+
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	for _, args := range []map[string]any{
+		{"assignees": []string{"alice"}},
+		{"clear_assignees": true},
+	} {
+		args["repository"] = "testuser/testrepo"
+		args["pull_request_number"] = 5
+		result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{Name: "pr_edit", Arguments: args})
+		if err != nil {
+			t.Fatalf("Failed to call pr_edit tool: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("pr_edit failed: %s", GetTextContent(result.Content))
+		}
+	}
+
+	if got := mock.Assignees("testuser", "testrepo", 5); len(got) != 0 {
+		t.Errorf("expected assignees to be cleared, got %v", got)
+	}
+}
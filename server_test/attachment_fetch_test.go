@@ -0,0 +1,225 @@
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var testLog = []byte("error: exit status 1\n")
+
+func addAttachmentFetchTestData(mock *MockGiteaServer) {
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Created: "2025-09-10T09:00:00Z", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddComments("testuser", "testrepo", []MockComment{
+		{ID: 300, Issue: 1, Author: "alice", Content: "Report attached.", Created: "2025-09-11T09:00:00Z", Updated: "2025-09-11T09:00:00Z"},
+	})
+	mock.AddIssueAttachments("testuser", "testrepo", 1, []MockAttachment{
+		{ID: 1, Name: "screenshot.png", UUID: "uuid-a", Data: testPNG},
+		{ID: 2, Name: "build-output", UUID: "uuid-b", Data: testLog},
+	})
+	mock.AddCommentAttachments("testuser", "testrepo", 300, []MockAttachment{
+		{ID: 3, Name: "report.pdf", UUID: "uuid-c", Data: testPDF},
+	})
+}
+
+func TestAttachmentList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantNames  []string
+		wantError  bool
+	}{
+		{
+			name:       "issue attachments (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "issue_number": 1},
+			wantText:   "Found 2 attachments",
+			wantNames:  []string{"screenshot.png", "build-output"},
+		},
+		{
+			name:       "comment attachments (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "comment_id": 300},
+			wantText:   "Found 1 attachments",
+			wantNames:  []string{"report.pdf"},
+		},
+		{
+			name:      "no attachments",
+			arguments: map[string]any{"repository": "testuser/testrepo", "issue_number": 2},
+			wantText:  "Found 0 attachments",
+		},
+		{
+			name:      "error: issue and comment",
+			arguments: map[string]any{"repository": "testuser/testrepo", "issue_number": 1, "comment_id": 300},
+			wantText:  "Invalid request: issue_number: only one of issue_number or comment_id may be set.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addAttachmentFetchTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "attachment_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call attachment_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			var names []string
+			attachments, _ := GetStructuredContent(result)["attachments"].([]any)
+			for _, a := range attachments {
+				attachment := a.(map[string]any)
+				names = append(names, attachment["name"].(string))
+				wantURL := fmt.Sprintf("%s/attachments/%s", mock.URL(), attachment["uuid"])
+				if attachment["download_url"] != wantURL {
+					t.Errorf("expected download URL %q, got %q", wantURL, attachment["download_url"])
+				}
+			}
+			if !cmp.Equal(tc.wantNames, names, cmpopts.EquateEmpty()) {
+				t.Error(cmp.Diff(tc.wantNames, names, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
+
+func TestAttachmentGet(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		env        map[string]string
+		arguments  map[string]any
+		wantText   string
+		// Expected content following the summary, with the mock URL as %s in resource URIs
+		wantContent mcp.Content
+		wantError   bool
+	}{
+		{
+			name:        "image as image content (gitea)",
+			clientType:  "gitea",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "issue_number": 1, "attachment_id": 1},
+			wantText:    fmt.Sprintf("Attachment screenshot.png (%d bytes, image/png)", len(testPNG)),
+			wantContent: &mcp.ImageContent{Data: testPNG, MIMEType: "image/png"},
+		},
+		{
+			name:        "log as text resource (forgejo)",
+			clientType:  "forgejo",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "issue_number": 1, "attachment_id": 2},
+			wantText:    fmt.Sprintf("Attachment build-output (%d bytes, text/plain; charset=utf-8)", len(testLog)),
+			wantContent: &mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "%s/attachments/uuid-b", MIMEType: "text/plain; charset=utf-8", Text: string(testLog)}},
+		},
+		{
+			name:        "pdf as blob resource",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "comment_id": 300, "attachment_id": 3},
+			wantText:    fmt.Sprintf("Attachment report.pdf (%d bytes, application/pdf)", len(testPDF)),
+			wantContent: &mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "%s/attachments/uuid-c", MIMEType: "application/pdf", Blob: testPDF}},
+		},
+		{
+			name:      "error: attachment too large",
+			env:       map[string]string{"FORGEJO_ATTACHMENT_MAX_SIZE": "16"},
+			arguments: map[string]any{"repository": "testuser/testrepo", "issue_number": 1, "attachment_id": 1},
+			wantText:  fmt.Sprintf("Failed to download attachment: attachment screenshot.png is %d bytes, exceeding the 16 byte limit", len(testPNG)),
+			wantError: true,
+		},
+		{
+			name:      "error: attachment not found",
+			arguments: map[string]any{"repository": "testuser/testrepo", "comment_id": 300, "attachment_id": 1},
+			wantText:  "Failed to get attachment: attachment 1 not found",
+			wantError: true,
+		},
+		{
+			name:      "error: missing attachment ID",
+			arguments: map[string]any{"repository": "testuser/testrepo", "issue_number": 1},
+			wantText:  "Invalid request: attachment_id: attachment_id is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addAttachmentFetchTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL":          mock.URL(),
+				"FORGEJO_AUTH_TOKEN":          "mock-token",
+				"FORGEJO_ATTACHMENT_MAX_SIZE": "4194304",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			for key, value := range tc.env {
+				env[key] = value
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "attachment_get",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call attachment_get tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			if resource, ok := tc.wantContent.(*mcp.EmbeddedResource); ok {
+				resource.Resource.URI = fmt.Sprintf(resource.Resource.URI, mock.URL())
+			}
+			if len(result.Content) != 2 {
+				t.Fatalf("expected summary and attachment content, got %d content items", len(result.Content))
+			}
+			opts := cmpopts.IgnoreUnexported(mcp.ImageContent{}, mcp.EmbeddedResource{}, mcp.ResourceContents{})
+			if !cmp.Equal(tc.wantContent, result.Content[1], opts) {
+				t.Error(cmp.Diff(tc.wantContent, result.Content[1], opts))
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package servertest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var (
+	testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+	testPDF = []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\n")
+)
+
+func addAttachmentTestData(mock *MockGiteaServer) {
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Created: "2025-09-10T09:00:00Z", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 10, Number: 5, Title: "Fix crash", State: "open", BaseRef: "main", UpdatedAt: "2025-09-12T10:30:00Z"},
+	})
+}
+
+func TestAttachments(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	sum := sha256.Sum256(testPNG)
+	pngName := fmt.Sprintf("attachment-%x.png", sum[:4])
+	image := map[string]any{"type": "image", "mimeType": "image/png", "data": base64.StdEncoding.EncodeToString(testPNG)}
+
+	testCases := []struct {
+		name      string
+		env       map[string]string
+		tool      string
+		arguments map[string]any
+		wantText  string
+		wantBody  string // Expected body of the created issue, pull request or comment, with the mock URL as %s
+		// Attachment names expected on the issue or pull request (number) or comment (commentID)
+		number    int
+		commentID int
+		names     []string
+	}{
+		{
+			name: "create issue with image",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			tool: "issue_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"title":       "Broken layout",
+				"body":        "See screenshot.",
+				"attachments": []any{image},
+			},
+			wantText: "Issue created successfully. Number: 2, Title: Broken layout",
+			wantBody: "See screenshot.\n\n![" + pngName + "](%s/attachments/uuid-1)",
+			number:   2,
+			names:    []string{pngName},
+		},
+		{
+			name: "comment on issue with text resource",
+			env: map[string]string{
+				"FORGEJO_ATTACHMENT_ENABLED":       "true",
+				"FORGEJO_ATTACHMENT_ALLOWED_TYPES": "image/*,text/plain",
+			},
+			tool: "issue_comment_create",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment":      "Build log attached.",
+				"attachments": []any{
+					map[string]any{"type": "resource", "resource": map[string]any{"uri": "file:///tmp/build.log", "mimeType": "text/plain", "text": "error: exit status 1\n"}},
+				},
+			},
+			wantText:  "Comment created successfully by testuser",
+			wantBody:  "Build log attached.\n\n[build.log](%s/attachments/uuid-2)",
+			commentID: 1,
+			names:     []string{"build.log"},
+		},
+		{
+			name: "comment on pull request with blob resource and image",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			tool: "pr_comment_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"comment":             "Report and screenshot.",
+				"attachments": []any{
+					map[string]any{"type": "resource", "resource": map[string]any{"uri": "https://example.com/reports/report.pdf", "mimeType": "application/pdf", "blob": base64.StdEncoding.EncodeToString(testPDF)}},
+					image,
+				},
+			},
+			wantText:  "Comment created successfully by testuser",
+			wantBody:  "Report and screenshot.\n\n[report.pdf](%s/attachments/uuid-2)\n![" + pngName + "](%s/attachments/uuid-3)",
+			commentID: 1,
+			names:     []string{"report.pdf", pngName},
+		},
+		{
+			name: "create pull request with image",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			tool: "pr_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"head":        "feature",
+				"base":        "main",
+				"title":       "Add feature",
+				"attachments": []any{image},
+			},
+			wantText: "Pull request created successfully. Number: 1, Title: Add feature",
+			wantBody: "![" + pngName + "](%s/attachments/uuid-2)",
+			number:   1,
+			names:    []string{pngName},
+		},
+		{
+			name: "error: attachments disabled",
+			tool: "issue_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"title":       "Broken layout",
+				"attachments": []any{image},
+			},
+			wantText: "Invalid attachment: attachments are disabled (set attachment.enabled to permit uploads)",
+		},
+		{
+			name: "error: type not allowed",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			tool: "issue_comment_create",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment":      "Build log attached.",
+				"attachments": []any{
+					map[string]any{"type": "resource", "resource": map[string]any{"uri": "file:///tmp/build.log", "text": "error: exit status 1\n"}},
+				},
+			},
+			wantText: "Invalid attachment: attachment 0: MIME type text/plain; charset=utf-8 not allowed",
+		},
+		{
+			name: "error: type only matches by prefix",
+			env: map[string]string{
+				"FORGEJO_ATTACHMENT_ENABLED":       "true",
+				"FORGEJO_ATTACHMENT_ALLOWED_TYPES": "image/*,text/",
+			},
+			tool: "issue_comment_create",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment":      "Build log attached.",
+				"attachments": []any{
+					map[string]any{"type": "resource", "resource": map[string]any{"uri": "file:///tmp/build.log", "text": "error: exit status 1\n"}},
+				},
+			},
+			wantText: "Invalid attachment: attachment 0: MIME type text/plain; charset=utf-8 not allowed",
+		},
+		{
+			name: "error: attachment too large",
+			env: map[string]string{
+				"FORGEJO_ATTACHMENT_ENABLED":  "true",
+				"FORGEJO_ATTACHMENT_MAX_SIZE": "16",
+			},
+			tool: "pr_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"head":        "feature",
+				"base":        "main",
+				"title":       "Add feature",
+				"attachments": []any{image},
+			},
+			wantText: fmt.Sprintf("Invalid attachment: attachment 0: file size %d exceeds maximum allowed 16", len(testPNG)),
+		},
+		{
+			name: "error: unsupported content type",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			tool: "issue_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"title":       "Broken layout",
+				"attachments": []any{map[string]any{"type": "text", "text": "hello"}},
+			},
+			wantText: "Invalid attachment: attachment 0: unsupported content type: text",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addAttachmentTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL":               mock.URL(),
+				"FORGEJO_AUTH_TOKEN":               "mock-token",
+				"FORGEJO_ATTACHMENT_ENABLED":       "false",
+				"FORGEJO_ATTACHMENT_MAX_SIZE":      "4194304",
+				"FORGEJO_ATTACHMENT_ALLOWED_TYPES": "image/*,application/pdf",
+			}
+			for key, value := range tc.env {
+				env[key] = value
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			text := GetTextContent(result.Content)
+			if tc.wantBody == "" {
+				if !result.IsError || text != tc.wantText {
+					t.Fatalf("expected error %q, got %q (is error: %v)", tc.wantText, text, result.IsError)
+				}
+				return
+			}
+			if result.IsError || !strings.HasPrefix(text, tc.wantText) {
+				t.Fatalf("expected result starting with %q, got %q (is error: %v)", tc.wantText, text, result.IsError)
+			}
+
+			wantBody := strings.ReplaceAll(tc.wantBody, "%s", mock.URL())
+			var gotBody any
+			structured := GetStructuredContent(result)
+			for _, field := range []string{"issue", "pull_request", "comment"} {
+				if entity, ok := structured[field].(map[string]any); ok {
+					gotBody = entity["body"]
+				}
+			}
+			if gotBody != wantBody {
+				t.Errorf("expected body %q, got %q", wantBody, gotBody)
+			}
+
+			var attachments []MockAttachment
+			if tc.commentID > 0 {
+				attachments = mock.CommentAttachments("testuser", "testrepo", tc.commentID)
+			} else {
+				attachments = mock.IssueAttachments("testuser", "testrepo", tc.number)
+			}
+			var names []string
+			for _, attachment := range attachments {
+				names = append(names, attachment.Name)
+			}
+			if !cmp.Equal(tc.names, names, cmpopts.EquateEmpty()) {
+				t.Error(cmp.Diff(tc.names, names, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
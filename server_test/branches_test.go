@@ -0,0 +1,419 @@
+package servertest
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func branchTestCommit(digit, message string) MockCommit {
+	return MockCommit{SHA: strings.Repeat(digit, 40), Message: message, Author: "alice", Email: "alice@example.com", Date: "2025-09-10T09:00:00Z"}
+}
+
+func addBranchTestData(mock *MockGiteaServer) {
+	base := []MockCommit{branchTestCommit("1", "Initial commit"), branchTestCommit("2", "Add README")}
+	mock.AddBranches("testuser", "testrepo", []MockBranch{
+		{Name: "main", Commits: append(slices.Clone(base), branchTestCommit("3", "Fix typo"))},
+		{Name: "feature", Commits: append(slices.Clone(base), branchTestCommit("a", "Add feature"), branchTestCommit("b", "Test feature\n\nWith details."))},
+		{Name: "release", Commits: slices.Clone(base), Protected: true},
+	})
+}
+
+func TestBranchList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantNames  []string
+		wantTotal  float64
+		wantError  bool
+	}{
+		{
+			name:       "all branches (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			wantText:   "Found 3 branches",
+			wantNames:  []string{"main", "feature", "release"},
+			wantTotal:  3,
+		},
+		{
+			name:       "second page (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "limit": 2, "offset": 2},
+			wantText:   "Found 1 branches",
+			wantNames:  []string{"release"},
+			wantTotal:  3,
+		},
+		{
+			name:      "error: invalid repository",
+			arguments: map[string]any{"repository": "testrepo"},
+			wantText:  "Invalid request: repository: repository must be in format 'owner/repo'.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addBranchTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "branch_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call branch_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			var names []string
+			branches, _ := structured["branches"].([]any)
+			for _, b := range branches {
+				branch := b.(map[string]any)
+				names = append(names, branch["name"].(string))
+				if branch["name"] == "release" && branch["protected"] != true {
+					t.Errorf("expected release to be protected, got %v", branch)
+				}
+				if commit, _ := branch["commit"].(map[string]any); commit["sha"] == "" || commit["author"] != "alice" {
+					t.Errorf("expected last commit of %s, got %v", branch["name"], commit)
+				}
+			}
+			if !cmp.Equal(tc.wantNames, names) {
+				t.Error(cmp.Diff(tc.wantNames, names))
+			}
+			if structured["total"] != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, structured["total"])
+			}
+		})
+	}
+}
+
+func TestBranchCreate(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantError  bool
+	}{
+		{
+			name:       "from default branch (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "topic"},
+			wantText:   "Created branch topic at " + strings.Repeat("3", 40),
+		},
+		{
+			name:       "from branch (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "topic", "ref": "feature"},
+			wantText:   "Created branch topic at " + strings.Repeat("b", 40),
+		},
+		{
+			name:      "from commit",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "topic", "ref": strings.Repeat("a", 40)},
+			wantText:  "Created branch topic at " + strings.Repeat("a", 40),
+		},
+		{
+			name:      "error: branch exists",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "feature"},
+			wantText:  "Failed to create branch: failed to create branch feature: The branch already exists.",
+			wantError: true,
+		},
+		{
+			name:      "error: unknown ref",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "topic", "ref": "missing"},
+			wantText:  "Failed to create branch: failed to create branch topic: The old ref missing does not exist",
+			wantError: true,
+		},
+		{
+			name:      "error: missing name",
+			arguments: map[string]any{"repository": "testuser/testrepo"},
+			wantText:  "Invalid request: name: branch name is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addBranchTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "branch_create",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call branch_create tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			branch, _ := GetStructuredContent(result)["branch"].(map[string]any)
+			if branch["name"] != "topic" {
+				t.Errorf("expected branch topic, got %v", branch)
+			}
+			if names := mock.Branches("testuser", "testrepo"); !cmp.Equal([]string{"main", "feature", "release", "topic"}, names) {
+				t.Errorf("expected topic to be added, got %v", names)
+			}
+		})
+	}
+}
+
+func TestBranchDelete(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantNames  []string
+		wantError  bool
+	}{
+		{
+			name:       "delete branch (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "feature"},
+			wantText:   "Deleted branch feature",
+			wantNames:  []string{"main", "release"},
+		},
+		{
+			name:       "error: protected branch (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "release"},
+			wantText:   "Failed to delete branch: failed to delete branch release: the branch is protected or is the default branch",
+			wantNames:  []string{"main", "feature", "release"},
+			wantError:  true,
+		},
+		{
+			name:      "error: default branch",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "main"},
+			wantText:  "Failed to delete branch: failed to delete branch main: the branch is protected or is the default branch",
+			wantNames: []string{"main", "feature", "release"},
+			wantError: true,
+		},
+		{
+			name:      "error: unknown branch",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "missing"},
+			wantText:  "Failed to delete branch: failed to delete branch missing: branch not found",
+			wantNames: []string{"main", "feature", "release"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addBranchTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "branch_delete",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call branch_delete tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if names := mock.Branches("testuser", "testrepo"); !cmp.Equal(tc.wantNames, names) {
+				t.Error(cmp.Diff(tc.wantNames, names))
+			}
+		})
+	}
+}
+
+// addSlashedBranch adds a branch whose name contains a slash, forked from the shared history
+func addSlashedBranch(mock *MockGiteaServer) {
+	mock.AddBranches("testuser", "testrepo", []MockBranch{
+		{Name: "feature/x", Commits: []MockCommit{branchTestCommit("1", "Initial commit"), branchTestCommit("2", "Add README"), branchTestCommit("c", "Start feature x")}},
+	})
+}
+
+func TestBranchCompare(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		setupMock  func(*MockGiteaServer)
+		arguments  map[string]any
+		wantText   string
+		wantAhead  []string
+		wantBehind []string
+		wantError  bool
+	}{
+		{
+			name:       "diverged branches (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "base": "main", "head": "feature"},
+			wantText:   "feature is 2 commits ahead of and 1 commits behind main",
+			wantAhead:  []string{"Add feature", "Test feature\n\nWith details."},
+			wantBehind: []string{"Fix typo"},
+		},
+		{
+			name:       "branch behind base (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "base": "main", "head": "release"},
+			wantText:   "release is 0 commits ahead of and 1 commits behind main",
+			wantBehind: []string{"Fix typo"},
+		},
+		{
+			name:       "branch name with slash (gitea)",
+			clientType: "gitea",
+			setupMock:  addSlashedBranch,
+			arguments:  map[string]any{"repository": "testuser/testrepo", "base": "main", "head": "feature/x"},
+			wantText:   "feature/x is 1 commits ahead of and 1 commits behind main",
+			wantAhead:  []string{"Start feature x"},
+			wantBehind: []string{"Fix typo"},
+		},
+		{
+			name:       "branch name with slash (forgejo)",
+			clientType: "forgejo",
+			setupMock:  addSlashedBranch,
+			arguments:  map[string]any{"repository": "testuser/testrepo", "base": "feature/x", "head": "main"},
+			wantText:   "main is 1 commits ahead of and 1 commits behind feature/x",
+			wantAhead:  []string{"Fix typo"},
+			wantBehind: []string{"Start feature x"},
+		},
+		{
+			name:       "commit lists limited",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "base": "main", "head": "feature", "limit": 1},
+			wantText:   "feature is 2 commits ahead of and 1 commits behind main",
+			wantAhead:  []string{"Add feature"},
+			wantBehind: []string{"Fix typo"},
+		},
+		{
+			name:      "error: unknown branch",
+			arguments: map[string]any{"repository": "testuser/testrepo", "base": "main", "head": "missing"},
+			wantText:  "Failed to compare branches: failed to compare main...missing: GetRefCommitID",
+			wantError: true,
+		},
+		{
+			name:      "error: missing head",
+			arguments: map[string]any{"repository": "testuser/testrepo", "base": "main"},
+			wantText:  "Invalid request: head: head branch is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addBranchTestData(mock)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "branch_compare",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call branch_compare tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			comparison, _ := GetStructuredContent(result)["comparison"].(map[string]any)
+			messages := func(key string) []string {
+				var result []string
+				commits, _ := comparison[key].([]any)
+				for _, c := range commits {
+					result = append(result, c.(map[string]any)["message"].(string))
+				}
+				return result
+			}
+			if ahead := messages("ahead_commits"); !cmp.Equal(tc.wantAhead, ahead) {
+				t.Error(cmp.Diff(tc.wantAhead, ahead))
+			}
+			if behind := messages("behind_commits"); !cmp.Equal(tc.wantBehind, behind) {
+				t.Error(cmp.Diff(tc.wantBehind, behind))
+			}
+		})
+	}
+}
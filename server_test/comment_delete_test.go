@@ -0,0 +1,214 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addCommentDeleteTestData(mock *MockGiteaServer) {
+	mock.AddComments("testuser", "testrepo", []MockComment{
+		{ID: 101, Content: "My comment", Author: "testuser", Created: "2025-09-10T10:00:00Z", Updated: "2025-09-10T10:00:00Z", Issue: 1},
+		{ID: 102, Content: "Someone else's comment", Author: "alice", Created: "2025-09-10T11:00:00Z", Updated: "2025-09-10T11:00:00Z", Issue: 1},
+		{ID: 103, Content: "Review feedback", Author: "testuser", Created: "2025-09-11T10:00:00Z", Updated: "2025-09-11T10:00:00Z", Issue: 5},
+		{ID: 104, Content: "Detached comment", Author: "testuser", Created: "2025-09-12T10:00:00Z", Updated: "2025-09-12T10:00:00Z"},
+	})
+}
+
+func TestCommentDelete(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name        string
+		clientType  string
+		allowOthers bool
+		tool        string
+		arguments   map[string]any
+		expect      *mcp.CallToolResult
+		remaining   []int
+	}{
+		{
+			name:       "delete own issue comment (gitea)",
+			clientType: "gitea",
+			tool:       "issue_comment_delete",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   101,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Comment 101 deleted"},
+				},
+				StructuredContent: map[string]any{"comment_id": float64(101), "deleted": true},
+			},
+			remaining: []int{102, 103, 104},
+		},
+		{
+			name:       "delete own pull request comment (forgejo)",
+			clientType: "forgejo",
+			tool:       "pr_comment_delete",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"comment_id":          103,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Comment 103 deleted"},
+				},
+				StructuredContent: map[string]any{"comment_id": float64(103), "deleted": true},
+			},
+			remaining: []int{101, 102, 104},
+		},
+		{
+			name:       "error: comment by another user (forgejo)",
+			clientType: "forgejo",
+			tool:       "issue_comment_delete",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   102,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Refusing to delete comment: failed to delete comment: comment was not written by the authenticated user: comment 102 was written by alice, not testuser (set allow_delete_others_comments to permit this)"},
+				},
+				IsError: true,
+			},
+			remaining: []int{101, 102, 103, 104},
+		},
+		{
+			name:        "delete comment by another user when allowed (gitea)",
+			clientType:  "gitea",
+			allowOthers: true,
+			tool:        "issue_comment_delete",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   102,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Comment 102 deleted"},
+				},
+				StructuredContent: map[string]any{"comment_id": float64(102), "deleted": true},
+			},
+			remaining: []int{101, 103, 104},
+		},
+		{
+			name:       "error: comment on a different issue",
+			clientType: "gitea",
+			tool:       "pr_comment_delete",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"comment_id":          101,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to delete comment: failed to delete comment: comment 101 does not belong to #5"},
+				},
+				IsError: true,
+			},
+			remaining: []int{101, 102, 103, 104},
+		},
+		{
+			name:       "error: comment without an issue (forgejo)",
+			clientType: "forgejo",
+			tool:       "issue_comment_delete",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   104,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to delete comment: failed to delete comment: comment 104 does not belong to #1"},
+				},
+				IsError: true,
+			},
+			remaining: []int{101, 102, 103, 104},
+		},
+		{
+			name:       "error: comment not found",
+			clientType: "forgejo",
+			tool:       "issue_comment_delete",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   999,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to delete comment: failed to delete comment: comment 999 not found in testuser/testrepo"},
+				},
+				IsError: true,
+			},
+			remaining: []int{101, 102, 103, 104},
+		},
+		{
+			name: "error: missing comment id",
+			tool: "pr_comment_delete",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: comment_id: must be no less than 1."},
+				},
+				IsError: true,
+			},
+			remaining: []int{101, 102, 103, 104},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addCommentDeleteTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			if tc.allowOthers {
+				env["FORGEJO_ALLOW_DELETE_OTHERS_COMMENTS"] = "true"
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+
+			var remaining []int
+			for _, c := range mock.Comments("testuser", "testrepo") {
+				remaining = append(remaining, c.ID)
+			}
+			if !cmp.Equal(tc.remaining, remaining) {
+				t.Error(cmp.Diff(tc.remaining, remaining))
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type commitStatusTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+func addCommitStatusTestData(mock *MockGiteaServer) {
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 1, Number: 1, Title: "Open PR", State: "open", BaseRef: "main", UpdatedAt: "2025-09-11T10:30:00Z"},
+	})
+	// Pull request head commits are reported as "abc123" by the mock server
+	mock.AddCommitStatuses("testuser", "testrepo", "abc123", []MockCommitStatus{
+		{ID: 1, Context: "ci/build", State: "success", Description: "Build passed", TargetURL: "https://ci.example.com/1"},
+		{ID: 2, Context: "ci/test", State: "failure", Description: "2 tests failed"},
+	})
+	mock.AddCommitStatuses("testuser", "testrepo", "main", []MockCommitStatus{
+		{ID: 3, Context: "ci/build", State: "pending"},
+	})
+	mock.AddCommitStatuses("testuser", "testrepo", "empty", nil)
+}
+
+func TestCommitStatus(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []commitStatusTestCase{
+		{
+			name:      "status of pull request head",
+			setupMock: addCommitStatusTestData,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "CI state for abc123: failure (2 checks)"},
+				},
+				StructuredContent: map[string]any{
+					"status": map[string]any{
+						"state":       "failure",
+						"sha":         "abc123",
+						"total_count": float64(2),
+						"statuses": []any{
+							map[string]any{
+								"id":          float64(1),
+								"context":     "ci/build",
+								"state":       "success",
+								"description": "Build passed",
+								"target_url":  "https://ci.example.com/1",
+								"creator":     "ci-bot",
+								"created":     "2025-10-01T12:00:00Z",
+								"updated":     "2025-10-01T12:00:00Z",
+							},
+							map[string]any{
+								"id":          float64(2),
+								"context":     "ci/test",
+								"state":       "failure",
+								"description": "2 tests failed",
+								"creator":     "ci-bot",
+								"created":     "2025-10-01T12:00:00Z",
+								"updated":     "2025-10-01T12:00:00Z",
+							},
+						},
+					},
+					"summary": map[string]any{
+						"state":   "failure",
+						"sha":     "abc123",
+						"total":   float64(2),
+						"success": float64(1),
+						"pending": float64(0),
+						"failed":  float64(1),
+						"warning": float64(0),
+					},
+				},
+			},
+		},
+		{
+			name:      "status of branch ref",
+			setupMock: addCommitStatusTestData,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"ref":        "main",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "CI state for main: pending (1 checks)"},
+				},
+				StructuredContent: map[string]any{
+					"status": map[string]any{
+						"state":       "pending",
+						"sha":         "main",
+						"total_count": float64(1),
+						"statuses": []any{
+							map[string]any{
+								"id":      float64(3),
+								"context": "ci/build",
+								"state":   "pending",
+								"creator": "ci-bot",
+								"created": "2025-10-01T12:00:00Z",
+								"updated": "2025-10-01T12:00:00Z",
+							},
+						},
+					},
+					"summary": map[string]any{
+						"state":   "pending",
+						"sha":     "main",
+						"total":   float64(1),
+						"success": float64(0),
+						"pending": float64(1),
+						"failed":  float64(0),
+						"warning": float64(0),
+					},
+				},
+			},
+		},
+		{
+			name:      "ref without checks",
+			setupMock: addCommitStatusTestData,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"ref":        "empty",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "CI state for empty: none (0 checks)"},
+				},
+				StructuredContent: map[string]any{
+					"status": map[string]any{
+						"state":       "",
+						"sha":         "empty",
+						"total_count": float64(0),
+						"statuses":    []any{},
+					},
+					"summary": map[string]any{
+						"state":   "none",
+						"sha":     "empty",
+						"total":   float64(0),
+						"success": float64(0),
+						"pending": float64(0),
+						"failed":  float64(0),
+						"warning": float64(0),
+					},
+				},
+			},
+		},
+		{
+			name:      "error: unknown ref",
+			setupMock: addCommitStatusTestData,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"ref":        "missing",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to get commit status: failed to get commit status: ref does not exist"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: both ref and pull request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"ref":                 "main",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: ref: only one of ref or pull_request_number may be set."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: neither ref nor pull request",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: ref: one of ref or pull_request_number is required."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "commit_status",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call commit_status tool: %v", err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+// TestCommitStatus_Paged verifies the check count covers statuses beyond the first page
+func TestCommitStatus_Paged(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	var statuses []MockCommitStatus
+	for i := range 20 {
+		statuses = append(statuses, MockCommitStatus{ID: i + 1, Context: fmt.Sprintf("ci/job-%d", i+1), State: "success"})
+	}
+	mock.AddCommitStatuses("testuser", "testrepo", "main", statuses)
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name:      "commit_status",
+		Arguments: map[string]any{"repository": "testuser/testrepo", "ref": "main"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call commit_status tool: %v", err)
+	}
+	if text := GetTextContent(result.Content); result.IsError || text != "CI state for main: success (20 checks)" {
+		t.Fatalf("unexpected result %q (is error: %v)", text, result.IsError)
+	}
+	status, _ := GetStructuredContent(result)["status"].(map[string]any)
+	if status["total_count"] != float64(20) {
+		t.Errorf("expected total_count 20, got %v", status["total_count"])
+	}
+}
+
+// TestPRFetch_CIChecks verifies pr_fetch summarizes the CI state of the pull request head
+func TestPRFetch_CIChecks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	addCommitStatusTestData(mock)
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name: "pr_fetch",
+		Arguments: map[string]any{
+			"repository":          "testuser/testrepo",
+			"pull_request_number": 1,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call pr_fetch tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %s", GetTextContent(result.Content))
+	}
+
+	want := map[string]any{
+		"state":   "failure",
+		"sha":     "abc123",
+		"total":   float64(2),
+		"success": float64(1),
+		"pending": float64(0),
+		"failed":  float64(1),
+		"warning": float64(0),
+	}
+	if diff := cmp.Diff(want, GetStructuredContent(result)["checks"]); diff != "" {
+		t.Errorf("CI summary mismatch (-want +got):\n%s", diff)
+	}
+}
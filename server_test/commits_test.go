@@ -0,0 +1,344 @@
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const commitTestDiff = `diff --git a/server/main.go b/server/main.go
+new file mode 100644
+--- /dev/null
++++ b/server/main.go
+@@ -0,0 +1,5 @@
++package main
++
++func main() {
++	serve()
++}
+diff --git a/go.mod b/go.mod
+--- a/go.mod
++++ b/go.mod
+@@ -1 +1,2 @@
+ module example.com/app
++go 1.25
+`
+
+func addCommitTestData(mock *MockGiteaServer) {
+	sha := func(digit string) string { return strings.Repeat(digit, 40) }
+	mock.AddBranches("testuser", "testrepo", []MockBranch{{Name: "main", Commits: []MockCommit{
+		{
+			SHA: sha("1"), Message: "Initial commit", Author: "Alice", Email: "alice@example.com", Login: "alice", Date: "2025-09-01T09:00:00Z",
+			Files: []MockChangedFile{{Filename: "README.md", Status: "added", Additions: 3}},
+		},
+		{
+			SHA: sha("2"), Message: "Add server\n\nServes the app.", Author: "Bob", Email: "bob@example.com", Date: "2025-09-05T09:00:00Z",
+			Files: []MockChangedFile{
+				{Filename: "server/main.go", Status: "added", Additions: 5},
+				{Filename: "go.mod", Status: "modified", Additions: 1},
+			},
+			Diff: commitTestDiff,
+		},
+		{
+			SHA: sha("3"), Message: "Fix typo", Author: "Alice", Email: "alice@example.com", Login: "alice", Date: "2025-09-10T09:00:00Z",
+			Files: []MockChangedFile{{Filename: "README.md", Status: "modified", Additions: 1, Deletions: 1}},
+		},
+	}}})
+	mock.AddPullRequestCommits("testuser", "testrepo", 7, []MockCommit{
+		{SHA: sha("a"), Message: "Add feature", Author: "Carol", Email: "carol@example.com", Date: "2025-09-11T09:00:00Z"},
+		{SHA: sha("b"), Message: "Test feature", Author: "Carol", Email: "carol@example.com", Date: "2025-09-12T09:00:00Z"},
+	})
+}
+
+// addLongHistory adds a "long" branch with one commit by Alice followed by count commits by Bob
+func addLongHistory(mock *MockGiteaServer, count int) {
+	commits := []MockCommit{{SHA: strings.Repeat("a", 40), Message: "Initial commit", Author: "Alice", Email: "alice@example.com", Login: "alice", Date: "2025-01-01T09:00:00Z"}}
+	for i := range count {
+		commits = append(commits, MockCommit{SHA: fmt.Sprintf("b%039d", i), Message: fmt.Sprintf("Change %d", i), Author: "Bob", Email: "bob@example.com", Date: "2025-02-01T09:00:00Z"})
+	}
+	mock.AddBranches("testuser", "testrepo", []MockBranch{{Name: "long", Commits: commits}})
+}
+
+func TestCommitList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name            string
+		clientType      string
+		arguments       map[string]any
+		setupMock       func(*MockGiteaServer)
+		wantText        string
+		wantSHAs        []string
+		wantTotal       any // nil when the total is omitted
+		wantScanLimited bool
+		wantError       bool
+	}{
+		{
+			name:       "default branch newest first (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			wantText:   "Found 3 commits",
+			wantSHAs:   []string{"3", "2", "1"},
+			wantTotal:  float64(3),
+		},
+		{
+			name:       "second page (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "limit": 1, "offset": 1},
+			wantText:   "Found 1 commits",
+			wantSHAs:   []string{"2"},
+			wantTotal:  float64(3),
+		},
+		{
+			name:       "by path (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "/server/"},
+			wantText:   "Found 1 commits",
+			wantSHAs:   []string{"2"},
+			wantTotal:  float64(1),
+		},
+		{
+			name:       "by date range (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "since": "2025-09-02", "until": "2025-09-08"},
+			wantText:   "Found 1 commits",
+			wantSHAs:   []string{"2"},
+			wantTotal:  float64(1),
+		},
+		{
+			name:       "by author username (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "author": "ALICE"},
+			wantText:   "Found 2 commits",
+			wantSHAs:   []string{"3", "1"},
+			wantTotal:  float64(2),
+		},
+		{
+			name:       "by author email with offset (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "author": "alice@example.com", "offset": 1},
+			wantText:   "Found 1 commits",
+			wantSHAs:   []string{"1"},
+			wantTotal:  float64(2),
+		},
+		{
+			name:       "by author with more commits than the limit (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "branch": "long", "author": "bob", "limit": 2},
+			setupMock:  func(mock *MockGiteaServer) { addLongHistory(mock, 60) },
+			wantText:   "Found 2 commits",
+			wantSHAs:   []string{"b", "b"},
+			wantTotal:  nil,
+		},
+		{
+			name:            "by author beyond the search limit (forgejo)",
+			clientType:      "forgejo",
+			arguments:       map[string]any{"repository": "testuser/testrepo", "branch": "long", "author": "alice"},
+			setupMock:       func(mock *MockGiteaServer) { addLongHistory(mock, 1000) },
+			wantText:        "Found 0 commits in the 1000 most recent commits searched for the author; older commits were not searched",
+			wantTotal:       nil,
+			wantScanLimited: true,
+		},
+		{
+			name:       "pull request commits (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "pull_request_number": 7},
+			wantText:   "Found 2 commits",
+			wantSHAs:   []string{"a", "b"},
+			wantTotal:  float64(2),
+		},
+		{
+			name:      "error: pull request with branch",
+			arguments: map[string]any{"repository": "testuser/testrepo", "pull_request_number": 7, "branch": "main"},
+			wantText:  "Invalid request: branch: cannot be combined with pull_request_number.",
+			wantError: true,
+		},
+		{
+			name:      "error: unknown branch",
+			arguments: map[string]any{"repository": "testuser/testrepo", "branch": "missing"},
+			wantText:  "Failed to list commits: failed to list commits: sha not found",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addCommitTestData(mock)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "commit_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call commit_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			var shas []string
+			commits, _ := structured["commits"].([]any)
+			for _, c := range commits {
+				commit := c.(map[string]any)
+				shas = append(shas, commit["sha"].(string)[:1])
+				if commit["author"] == "" || commit["date"] == "" {
+					t.Errorf("expected author and date, got %v", commit)
+				}
+			}
+			if !cmp.Equal(tc.wantSHAs, shas) {
+				t.Error(cmp.Diff(tc.wantSHAs, shas))
+			}
+			if structured["total"] != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, structured["total"])
+			}
+			if scanLimited, _ := structured["scan_limited"].(bool); scanLimited != tc.wantScanLimited {
+				t.Errorf("expected scan_limited %v, got %v", tc.wantScanLimited, scanLimited)
+			}
+		})
+	}
+}
+
+func TestCommitGet(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name          string
+		clientType    string
+		arguments     map[string]any
+		wantText      string
+		wantParents   []any
+		wantFiles     []any
+		wantDiffFiles []any
+		wantError     bool
+	}{
+		{
+			name:        "by sha (gitea)",
+			clientType:  "gitea",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "sha": strings.Repeat("2", 40)},
+			wantText:    "Commit " + strings.Repeat("2", 40) + " by Bob: Add server",
+			wantParents: []any{strings.Repeat("1", 40)},
+			wantFiles: []any{
+				map[string]any{"filename": "server/main.go", "status": "added"},
+				map[string]any{"filename": "go.mod", "status": "modified"},
+			},
+		},
+		{
+			name:        "by branch (forgejo)",
+			clientType:  "forgejo",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "sha": "main"},
+			wantText:    "Commit " + strings.Repeat("3", 40) + " by Alice: Fix typo",
+			wantParents: []any{strings.Repeat("2", 40)},
+			wantFiles:   []any{map[string]any{"filename": "README.md", "status": "modified"}},
+		},
+		{
+			name:        "with truncated diff (forgejo)",
+			clientType:  "forgejo",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "sha": strings.Repeat("2", 40), "include_diff": true, "max_lines_per_file": 6},
+			wantText:    "Commit " + strings.Repeat("2", 40) + " by Bob: Add server",
+			wantParents: []any{strings.Repeat("1", 40)},
+			wantFiles: []any{
+				map[string]any{"filename": "server/main.go", "status": "added"},
+				map[string]any{"filename": "go.mod", "status": "modified"},
+			},
+			wantDiffFiles: []any{
+				map[string]any{"path": "server/main.go", "lines": float64(10), "truncated": true},
+				map[string]any{"path": "go.mod", "lines": float64(6), "truncated": false},
+			},
+		},
+		{
+			name:      "error: unknown commit",
+			arguments: map[string]any{"repository": "testuser/testrepo", "sha": "deadbeef"},
+			wantText:  "Failed to get commit: commit deadbeef not found",
+			wantError: true,
+		},
+		{
+			name:      "error: missing sha",
+			arguments: map[string]any{"repository": "testuser/testrepo"},
+			wantText:  "Invalid request: sha: commit sha is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addCommitTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "commit_get",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call commit_get tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			commit, _ := structured["commit"].(map[string]any)
+			if !cmp.Equal(tc.wantParents, commit["parents"]) {
+				t.Error(cmp.Diff(tc.wantParents, commit["parents"]))
+			}
+			if !cmp.Equal(tc.wantFiles, commit["files"]) {
+				t.Error(cmp.Diff(tc.wantFiles, commit["files"]))
+			}
+			if _, ok := commit["stats"].(map[string]any); !ok {
+				t.Errorf("expected commit stats, got %v", commit)
+			}
+			diffFiles, _ := structured["diff_files"].([]any)
+			if !cmp.Equal(tc.wantDiffFiles, diffFiles) {
+				t.Error(cmp.Diff(tc.wantDiffFiles, diffFiles))
+			}
+			if diff, _ := structured["diff"].(string); (tc.wantDiffFiles != nil) != strings.Contains(diff, "+go 1.25") {
+				t.Errorf("unexpected diff %q", diff)
+			}
+		})
+	}
+}
@@ -136,7 +136,7 @@ func TestEditIssue(t *testing.T) {
 			},
 			expect: &mcp.CallToolResult{
 				Content: []mcp.Content{
-					&mcp.TextContent{Text: "At least one of title, body, or state must be provided"},
+					&mcp.TextContent{Text: "At least one of title, body, state, milestone, clear_milestone, assignees, or clear_assignees must be provided"},
 				},
 				StructuredContent: nil,
 				IsError:           true,
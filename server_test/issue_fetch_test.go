@@ -0,0 +1,180 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addIssueFetchTestData(mock *MockGiteaServer) {
+	mock.AddLabels("testuser", "testrepo", []MockLabel{
+		{ID: 101, Name: "bug", Color: "ee0701"},
+	})
+	mock.AddMilestones("testuser", "testrepo", []MockMilestone{
+		{ID: 201, Title: "v1.0", State: "open"},
+	})
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{
+			Index: 1, Title: "Crash on start", Body: "Stack trace attached", State: "closed", Author: "alice",
+			Created: "2025-09-10T09:00:00Z", Updated: "2025-09-12T09:00:00Z",
+			Comments: 3, Locked: true, DueDate: "2025-10-01T00:00:00Z",
+		},
+	})
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 10, Number: 5, Title: "Fix crash", State: "open", BaseRef: "main", UpdatedAt: "2025-09-12T10:30:00Z"},
+	})
+	mock.SetIssueLabels("testuser", "testrepo", 1, []int{101})
+	mock.SetIssueMilestone("testuser", "testrepo", 1, 201)
+	mock.SetAssignees("testuser", "testrepo", 1, "bob")
+	mock.AddIssueReactions("testuser", "testrepo", 1,
+		MockReaction{User: "bob", Content: "+1"},
+		MockReaction{User: "carol", Content: "heart"},
+		MockReaction{User: "dave", Content: "+1"},
+	)
+}
+
+func TestIssueFetch(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name      string
+		arguments map[string]any
+		expect    func(baseURL string) *mcp.CallToolResult
+	}{
+		{
+			name: "issue with full detail",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+			},
+			expect: func(baseURL string) *mcp.CallToolResult {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{
+						&mcp.TextContent{Text: "Issue #1: Crash on start (closed)"},
+					},
+					StructuredContent: map[string]any{
+						"issue": map[string]any{
+							"id":        float64(1),
+							"number":    float64(1),
+							"title":     "Crash on start",
+							"body":      "Stack trace attached",
+							"state":     "closed",
+							"user":      "alice",
+							"created":   "2025-09-10T09:00:00Z",
+							"updated":   "2025-09-12T09:00:00Z",
+							"html_url":  baseURL + "/testuser/testrepo/issues/1",
+							"labels":    []any{map[string]any{"id": float64(101), "name": "bug", "color": "ee0701"}},
+							"milestone": map[string]any{"id": float64(201), "title": "v1.0", "state": "open", "open_issues": float64(0), "closed_issues": float64(0)},
+							"assignees": []any{"bob"},
+							"comments":  float64(3),
+							"is_locked": true,
+							"closed_at": "2025-09-12T09:00:00Z",
+							"due_date":  "2025-10-01T00:00:00Z",
+							"reactions": []any{
+								map[string]any{"content": "+1", "count": float64(2), "users": []any{"bob", "dave"}},
+								map[string]any{"content": "heart", "count": float64(1), "users": []any{"carol"}},
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "pull request links the pull request",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 5,
+			},
+			expect: func(baseURL string) *mcp.CallToolResult {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{
+						&mcp.TextContent{Text: "Issue #5: Fix crash (open)"},
+					},
+					StructuredContent: map[string]any{
+						"issue": map[string]any{
+							"id":        float64(10),
+							"number":    float64(5),
+							"title":     "Fix crash",
+							"body":      "",
+							"state":     "open",
+							"user":      "testuser",
+							"created":   "2025-09-11T10:30:00Z",
+							"updated":   "2025-09-12T10:30:00Z",
+							"html_url":  baseURL + "/testuser/testrepo/pulls/5",
+							"comments":  float64(0),
+							"is_locked": false,
+							"pull_request": map[string]any{
+								"number":   float64(5),
+								"html_url": baseURL + "/testuser/testrepo/pulls/5",
+								"merged":   false,
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "error: unknown issue",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 99,
+			},
+			expect: func(string) *mcp.CallToolResult {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{
+						&mcp.TextContent{Text: "Failed to fetch issue: failed to get issue: issue does not exist"},
+					},
+					IsError: true,
+				}
+			},
+		},
+		{
+			name: "error: missing issue number",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+			},
+			expect: func(string) *mcp.CallToolResult {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{
+						&mcp.TextContent{Text: "Invalid request: issue_number: issue number is required."},
+					},
+					IsError: true,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addIssueFetchTestData(mock)
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "issue_fetch",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call issue_fetch tool: %v", err)
+			}
+
+			expect := tc.expect(mock.URL())
+			if !cmp.Equal(expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addIssueFilterTestData(mock *MockGiteaServer) {
+	mock.AddLabels("testuser", "testrepo", []MockLabel{
+		{ID: 101, Name: "bug", Color: "ee0701"},
+		{ID: 102, Name: "urgent", Color: "b60205"},
+	})
+	mock.AddMilestones("testuser", "testrepo", []MockMilestone{
+		{ID: 201, Title: "v1.0", State: "open"},
+	})
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", Body: "Seen by @alice", State: "open", Author: "alice", Updated: "2025-09-01T09:00:00Z"},
+		{Index: 2, Title: "Slow startup", State: "open", Updated: "2025-09-20T09:00:00Z"},
+		{Index: 3, Title: "Crash on exit", State: "closed", Author: "bob", Updated: "2025-10-01T09:00:00Z"},
+	})
+	mock.SetIssueLabels("testuser", "testrepo", 1, []int{101, 102})
+	mock.SetIssueLabels("testuser", "testrepo", 3, []int{101})
+	mock.SetIssueMilestone("testuser", "testrepo", 2, 201)
+	mock.SetAssignees("testuser", "testrepo", 3, "alice")
+}
+
+func TestListIssuesFilters(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	bug := map[string]any{"id": float64(101), "name": "bug", "color": "ee0701"}
+	urgent := map[string]any{"id": float64(102), "name": "urgent", "color": "b60205"}
+	issue1 := map[string]any{"id": float64(1), "number": float64(1), "title": "Crash on start", "state": "open", "body": "Seen by @alice", "user": "alice",
+		"created": "2025-09-01T09:00:00Z", "updated": "2025-09-01T09:00:00Z", "labels": []any{bug, urgent}}
+	issue2 := map[string]any{
+		"id": float64(2), "number": float64(2), "title": "Slow startup", "state": "open", "user": "testuser",
+		"created": "2025-09-20T09:00:00Z", "updated": "2025-09-20T09:00:00Z",
+		"milestone": map[string]any{"id": float64(201), "title": "v1.0", "state": "open", "open_issues": float64(0), "closed_issues": float64(0)},
+	}
+	issue3 := map[string]any{"id": float64(3), "number": float64(3), "title": "Crash on exit", "state": "closed", "user": "bob",
+		"created": "2025-10-01T09:00:00Z", "updated": "2025-10-01T09:00:00Z", "labels": []any{bug}, "assignees": []any{"alice"}}
+	found := func(issues ...any) *mcp.CallToolResult {
+		result := &mcp.CallToolResult{
+			Content:           []mcp.Content{&mcp.TextContent{Text: "Found 0 issues"}},
+			StructuredContent: map[string]any{},
+		}
+		if len(issues) > 0 {
+			result.Content = []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("Found %d issues", len(issues))}}
+			result.StructuredContent = map[string]any{"issues": issues}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name       string
+		clientType string // FORGEJO_CLIENT_TYPE; empty runs against both clients
+		arguments  map[string]any
+		expect     *mcp.CallToolResult
+	}{
+		{
+			name:      "all states",
+			arguments: map[string]any{"state": "all"},
+			expect:    found(issue1, issue2, issue3),
+		},
+		{
+			name:      "closed issues",
+			arguments: map[string]any{"state": "closed"},
+			expect:    found(issue3),
+		},
+		{
+			name:      "labels must all match",
+			arguments: map[string]any{"state": "all", "labels": []string{"bug", "urgent"}},
+			expect:    found(issue1),
+		},
+		{
+			name:      "milestone",
+			arguments: map[string]any{"milestone": "v1.0"},
+			expect:    found(issue2),
+		},
+		{
+			name:      "assignee",
+			arguments: map[string]any{"state": "all", "assignee": "alice"},
+			expect:    found(issue3),
+		},
+		{
+			name:      "author",
+			arguments: map[string]any{"state": "all", "author": "bob"},
+			expect:    found(issue3),
+		},
+		{
+			name:      "mentioned",
+			arguments: map[string]any{"mentioned": "alice"},
+			expect:    found(issue1),
+		},
+		{
+			name:      "keyword query",
+			arguments: map[string]any{"state": "all", "query": "crash"},
+			expect:    found(issue1, issue3),
+		},
+		{
+			name:      "updated between dates",
+			arguments: map[string]any{"state": "all", "since": "2025-09-10", "before": "2025-10-01T00:00:00Z"},
+			expect:    found(issue2),
+		},
+		{
+			name:      "pulls only",
+			arguments: map[string]any{"type": "pulls"},
+			expect:    found(),
+		},
+		{
+			name:       "sort oldest first",
+			clientType: "forgejo",
+			arguments:  map[string]any{"state": "all", "sort": "oldest"},
+			expect:     found(issue3, issue2, issue1),
+		},
+		{
+			name:       "error: sort on gitea",
+			clientType: "gitea",
+			arguments:  map[string]any{"sort": "oldest"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list issues: failed to list issues: operation not supported by this remote: sorting issues requires a Forgejo remote"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: invalid state",
+			arguments: map[string]any{"state": "merged"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: state: state must be 'open', 'closed', or 'all'."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: invalid since",
+			arguments: map[string]any{"since": "last week"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: since: since must be YYYY-MM-DD or RFC 3339."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: invalid sort",
+			arguments: map[string]any{"sort": "popular"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: sort: sort must be one of latest, oldest, recentupdate, leastupdate, mostcomment, leastcomment, nearduedate, or farduedate."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		clientTypes := []string{"gitea", "forgejo"}
+		if tc.clientType != "" {
+			clientTypes = []string{tc.clientType}
+		}
+		for _, clientType := range clientTypes {
+			t.Run(tc.name+"/"+clientType, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+				t.Cleanup(cancel)
+
+				mock := NewMockGiteaServer(t)
+				addIssueFilterTestData(mock)
+
+				ts := NewTestServer(t, ctx, map[string]string{
+					"FORGEJO_REMOTE_URL":  mock.URL(),
+					"FORGEJO_AUTH_TOKEN":  "mock-token",
+					"FORGEJO_CLIENT_TYPE": clientType,
+				})
+				if err := ts.Initialize(); err != nil {
+					t.Fatalf("Failed to initialize test server: %v", err)
+				}
+
+				arguments := map[string]any{"repository": "testuser/testrepo"}
+				for k, v := range tc.arguments {
+					arguments[k] = v
+				}
+				result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+					Name:      "issue_list",
+					Arguments: arguments,
+				})
+				if err != nil {
+					t.Fatalf("Failed to call issue_list tool: %v", err)
+				}
+
+				if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+					t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+				}
+			})
+		}
+	}
+}
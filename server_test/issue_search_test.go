@@ -0,0 +1,144 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addIssueSearchTestData(mock *MockGiteaServer) {
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Updated: "2025-09-10T09:00:00Z"},
+		{Index: 2, Title: "Old bug", State: "closed", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddIssues("myorg", "api", []MockIssue{
+		{Index: 7, Title: "Rate limit crash", State: "open", Author: "alice", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddPullRequests("myorg", "api", []MockPullRequest{
+		{ID: 30, Number: 8, Title: "Fix rate limit", State: "open", BaseRef: "main"},
+	})
+	mock.SetAssignees("myorg", "api", 7, "testuser")
+	mock.SetReviewRequests("myorg", "api", 8, "testuser")
+}
+
+func TestSearchIssues(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	apiIssue := map[string]any{"id": float64(7), "number": float64(7), "title": "Rate limit crash", "state": "open", "user": "alice", "created": "2025-09-10T09:00:00Z", "updated": "2025-09-10T09:00:00Z", "assignees": []any{"testuser"}, "repository": "myorg/api"}
+	apiPull := map[string]any{"id": float64(30), "number": float64(8), "title": "Fix rate limit", "state": "open", "user": "testuser", "created": "2025-09-11T10:30:00Z", "updated": "2025-09-11T10:30:00Z", "repository": "myorg/api", "pull_request": true}
+	crash := map[string]any{"id": float64(1), "number": float64(1), "title": "Crash on start", "state": "open", "user": "testuser", "created": "2025-09-10T09:00:00Z", "updated": "2025-09-10T09:00:00Z", "repository": "testuser/testrepo"}
+
+	testCases := []struct {
+		name       string
+		clientType string // FORGEJO_CLIENT_TYPE
+		arguments  map[string]any
+		expect     *mcp.CallToolResult
+	}{
+		{
+			name:      "open issues and pull requests across repositories",
+			arguments: map[string]any{},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 3 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiIssue, apiPull, crash}},
+			},
+		},
+		{
+			name:      "owner and keyword",
+			arguments: map[string]any{"owner": "myorg", "type": "issues", "query": "crash"},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 1 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiIssue}},
+			},
+		},
+		{
+			name:       "review requested",
+			clientType: "forgejo",
+			arguments:  map[string]any{"involvement": "review_requested"},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 1 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiPull}},
+			},
+		},
+		{
+			name:       "assigned",
+			clientType: "forgejo",
+			arguments:  map[string]any{"involvement": "assigned", "state": "all"},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 1 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiIssue}},
+			},
+		},
+		{
+			name:       "review requested (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"involvement": "review_requested"},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 1 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiPull}},
+			},
+		},
+		{
+			name:       "assigned (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"involvement": "assigned", "state": "all"},
+			expect: &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: "Found 1 issues"}},
+				StructuredContent: map[string]any{"issues": []any{apiIssue}},
+			},
+		},
+		{
+			name:      "error: team without owner",
+			arguments: map[string]any{"team": "core"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: owner: owner is required when team is set."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: invalid involvement",
+			arguments: map[string]any{"involvement": "watching"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: involvement: involvement must be one of assigned, created, mentioned, review_requested, or reviewed."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addIssueSearchTestData(mock)
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL":  mock.URL(),
+				"FORGEJO_AUTH_TOKEN":  "mock-token",
+				"FORGEJO_CLIENT_TYPE": tc.clientType,
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "search_issues",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call search_issues tool: %v", err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
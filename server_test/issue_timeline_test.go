@@ -0,0 +1,169 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addTimelineTestData(mock *MockGiteaServer) {
+	mock.AddTimelineEvents("testuser", "testrepo", 1,
+		MockTimelineEvent{ID: 1, Type: "comment", User: "alice", Body: "Crashes on every start", Created: "2025-09-10T09:00:00Z"},
+		MockTimelineEvent{ID: 2, Type: "label", User: "bob", Body: "1", Label: "bug", Created: "2025-09-10T09:05:00Z"},
+		MockTimelineEvent{ID: 3, Type: "assignees", User: "bob", Assignee: "carol", Created: "2025-09-10T09:06:00Z"},
+		MockTimelineEvent{ID: 4, Type: "milestone", User: "bob", Milestone: "v1.1", OldMilestone: "v1.0", Created: "2025-09-10T09:07:00Z"},
+		MockTimelineEvent{ID: 5, Type: "commit_ref", User: "carol", RefCommit: "abc123", Created: "2025-09-11T10:00:00Z"},
+		MockTimelineEvent{ID: 6, Type: "pull_ref", User: "carol", RefIssue: "testuser/testrepo#5", Created: "2025-09-11T10:30:00Z"},
+		MockTimelineEvent{ID: 7, Type: "close", User: "carol", Created: "2025-09-12T08:00:00Z"},
+		MockTimelineEvent{ID: 8, Type: "reopen", User: "alice", Created: "2025-09-13T08:00:00Z"},
+	)
+	mock.AddTimelineEvents("testuser", "testrepo", 5,
+		MockTimelineEvent{ID: 20, Type: "review_request", User: "carol", Assignee: "bob", Created: "2025-09-11T10:31:00Z"},
+		MockTimelineEvent{ID: 21, Type: "review_request", User: "carol", Team: "core", Removed: true, Created: "2025-09-11T10:32:00Z"},
+		MockTimelineEvent{ID: 22, Type: "change_title", User: "carol", OldTitle: "WIP: fix", NewTitle: "Fix crash", Created: "2025-09-11T10:33:00Z"},
+		MockTimelineEvent{ID: 23, Type: "label", User: "carol", Label: "wip", Created: "2025-09-11T10:34:00Z"},
+	)
+}
+
+func TestIssueTimeline(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name      string
+		arguments map[string]any
+		expect    *mcp.CallToolResult
+	}{
+		{
+			name: "issue events in order",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 8 timeline events for #1"},
+				},
+				StructuredContent: map[string]any{
+					"events": []any{
+						map[string]any{"id": float64(1), "type": "comment", "category": "comment", "actor": "alice", "created": "2025-09-10T09:00:00Z", "summary": "commented", "body": "Crashes on every start"},
+						map[string]any{"id": float64(2), "type": "label", "category": "label", "actor": "bob", "created": "2025-09-10T09:05:00Z", "summary": "added label bug", "action": "added", "label": "bug"},
+						map[string]any{"id": float64(3), "type": "assignees", "category": "assignee", "actor": "bob", "created": "2025-09-10T09:06:00Z", "summary": "added assignee carol", "action": "added", "assignee": "carol"},
+						map[string]any{"id": float64(4), "type": "milestone", "category": "milestone", "actor": "bob", "created": "2025-09-10T09:07:00Z", "summary": "changed milestone from v1.0 to v1.1", "action": "changed", "milestone": "v1.1", "old_milestone": "v1.0"},
+						map[string]any{"id": float64(5), "type": "commit_ref", "category": "reference", "actor": "carol", "created": "2025-09-11T10:00:00Z", "summary": "referenced this in commit abc123", "ref_commit": "abc123"},
+						map[string]any{"id": float64(6), "type": "pull_ref", "category": "reference", "actor": "carol", "created": "2025-09-11T10:30:00Z", "summary": "referenced this in testuser/testrepo#5", "ref_issue": "testuser/testrepo#5"},
+						map[string]any{"id": float64(7), "type": "close", "category": "state", "actor": "carol", "created": "2025-09-12T08:00:00Z", "summary": "closed this", "action": "closed"},
+						map[string]any{"id": float64(8), "type": "reopen", "category": "state", "actor": "alice", "created": "2025-09-13T08:00:00Z", "summary": "reopened this", "action": "reopened"},
+					},
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name: "pull request events with pagination",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 5,
+				"limit":        2,
+				"offset":       2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 timeline events for #5"},
+				},
+				StructuredContent: map[string]any{
+					"events": []any{
+						map[string]any{"id": float64(22), "type": "change_title", "category": "title", "actor": "carol", "created": "2025-09-11T10:33:00Z", "summary": `changed title from "WIP: fix" to "Fix crash"`, "action": "changed", "old_title": "WIP: fix", "new_title": "Fix crash"},
+						map[string]any{"id": float64(23), "type": "label", "category": "label", "actor": "carol", "created": "2025-09-11T10:34:00Z", "summary": "removed label wip", "action": "removed", "label": "wip"},
+					},
+					"limit":  float64(2),
+					"offset": float64(2),
+				},
+			},
+		},
+		{
+			name: "review requests",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 5,
+				"limit":        2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 timeline events for #5"},
+				},
+				StructuredContent: map[string]any{
+					"events": []any{
+						map[string]any{"id": float64(20), "type": "review_request", "category": "review_request", "actor": "carol", "created": "2025-09-11T10:31:00Z", "summary": "requested review from bob", "action": "added", "reviewer": "bob"},
+						map[string]any{"id": float64(21), "type": "review_request", "category": "review_request", "actor": "carol", "created": "2025-09-11T10:32:00Z", "summary": "removed review request for team core", "action": "removed", "reviewer": "team core"},
+					},
+					"limit":  float64(2),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name: "error: unknown issue",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 99,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list issue timeline: failed to list issue timeline: issue does not exist"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid limit",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"limit":        500,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: limit: must be no greater than 100."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, clientType := range []string{"gitea", "forgejo"} {
+			t.Run(tc.name+"/"+clientType, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+				t.Cleanup(cancel)
+
+				mock := NewMockGiteaServer(t)
+				addTimelineTestData(mock)
+
+				ts := NewTestServer(t, ctx, map[string]string{
+					"FORGEJO_REMOTE_URL":  mock.URL(),
+					"FORGEJO_AUTH_TOKEN":  "mock-token",
+					"FORGEJO_CLIENT_TYPE": clientType,
+				})
+				if err := ts.Initialize(); err != nil {
+					t.Fatalf("Failed to initialize test server: %v", err)
+				}
+
+				result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+					Name:      "issue_timeline",
+					Arguments: tc.arguments,
+				})
+				if err != nil {
+					t.Fatalf("Failed to call issue_timeline tool: %v", err)
+				}
+
+				if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+					t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type labelTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	tool      string
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+func addLabelTestData(mock *MockGiteaServer) {
+	mock.AddLabels("testuser", "testrepo", []MockLabel{
+		{ID: 101, Name: "bug", Color: "ee0701", Description: "Something is broken"},
+		{ID: 102, Name: "Enhancement", Color: "84b6eb"},
+		{ID: 103, Name: "docs", Color: "0075ca"},
+	})
+	mock.SetIssueLabels("testuser", "testrepo", 1, []int{101})
+}
+
+func TestLabels(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []labelTestCase{
+		{
+			name:      "list labels",
+			setupMock: addLabelTestData,
+			tool:      "label_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"limit":      2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 labels"},
+				},
+				StructuredContent: map[string]any{
+					"labels": []any{
+						map[string]any{"id": float64(101), "name": "bug", "color": "ee0701", "description": "Something is broken"},
+						map[string]any{"id": float64(102), "name": "Enhancement", "color": "84b6eb"},
+					},
+					"total":  float64(2),
+					"limit":  float64(2),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "create label",
+			setupMock: addLabelTestData,
+			tool:      "label_create",
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"name":        "needs-triage",
+				"color":       "#fbca04",
+				"description": "Awaiting review",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Label created successfully: needs-triage"},
+				},
+				StructuredContent: map[string]any{
+					"label": map[string]any{"id": float64(1), "name": "needs-triage", "color": "#fbca04", "description": "Awaiting review"},
+				},
+			},
+		},
+		{
+			name: "error: invalid label color",
+			tool: "label_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"name":       "needs-triage",
+				"color":      "yellow",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: color: color must be a six digit hex value such as '#ee0701'."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "edit label by name",
+			setupMock: addLabelTestData,
+			tool:      "label_edit",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"name":       "docs",
+				"new_name":   "documentation",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Label edited successfully: documentation"},
+				},
+				StructuredContent: map[string]any{
+					"label": map[string]any{"id": float64(103), "name": "documentation", "color": "0075ca"},
+				},
+			},
+		},
+		{
+			name: "error: edit without changes",
+			tool: "label_edit",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"name":       "docs",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: new_name: at least one of new_name, color, or description must be provided."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "add labels by name",
+			setupMock: addLabelTestData,
+			tool:      "issue_label_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"enhancement", "docs"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue #1 labels: bug, Enhancement, docs"},
+				},
+				StructuredContent: map[string]any{
+					"labels": []any{
+						map[string]any{"id": float64(101), "name": "bug", "color": "ee0701", "description": "Something is broken"},
+						map[string]any{"id": float64(102), "name": "Enhancement", "color": "84b6eb"},
+						map[string]any{"id": float64(103), "name": "docs", "color": "0075ca"},
+					},
+				},
+			},
+		},
+		{
+			name: "add organization label",
+			setupMock: func(mock *MockGiteaServer) {
+				addLabelTestData(mock)
+				mock.AddOrgLabels("testuser", []MockLabel{{ID: 201, Name: "priority/high", Color: "d73a4a"}})
+			},
+			tool: "issue_label_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"Priority/High"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue #1 labels: bug, priority/high"},
+				},
+				StructuredContent: map[string]any{
+					"labels": []any{
+						map[string]any{"id": float64(101), "name": "bug", "color": "ee0701", "description": "Something is broken"},
+						map[string]any{"id": float64(201), "name": "priority/high", "color": "d73a4a"},
+					},
+				},
+			},
+		},
+		{
+			name: "add label beyond a capped page",
+			setupMock: func(mock *MockGiteaServer) {
+				addLabelTestData(mock)
+				mock.SetMaxResponseItems(2)
+			},
+			tool: "issue_label_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"docs"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue #1 labels: bug, docs"},
+				},
+				StructuredContent: map[string]any{
+					"labels": []any{
+						map[string]any{"id": float64(101), "name": "bug", "color": "ee0701", "description": "Something is broken"},
+						map[string]any{"id": float64(103), "name": "docs", "color": "0075ca"},
+					},
+				},
+			},
+		},
+		{
+			name:      "error: unknown label",
+			setupMock: addLabelTestData,
+			tool:      "issue_label_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"bug", "wontfix", "duplicate"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to add labels: failed to add labels: labels not found in testuser/testrepo: wontfix, duplicate"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: blank label name",
+			tool: "issue_label_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"bug", "  "},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: labels: (1: label cannot be only whitespace.)."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "remove label",
+			setupMock: addLabelTestData,
+			tool:      "issue_label_remove",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{"bug"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue #1 has no labels"},
+				},
+				StructuredContent: map[string]any{
+					"labels": []any{},
+				},
+			},
+		},
+		{
+			name: "error: no labels",
+			tool: "issue_label_remove",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"labels":       []string{},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: labels: at least one label is required."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+func TestIssueListLabels(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open"},
+	})
+	addLabelTestData(mock)
+
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name:      "issue_list",
+		Arguments: map[string]any{"repository": "testuser/testrepo"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call issue_list tool: %v", err)
+	}
+
+	issues, _ := GetStructuredContent(result)["issues"].([]any)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %v", result)
+	}
+	want := []any{
+		map[string]any{"id": float64(101), "name": "bug", "color": "ee0701", "description": "Something is broken"},
+	}
+	if got := issues[0].(map[string]any)["labels"]; !cmp.Equal(want, got) {
+		t.Error(cmp.Diff(want, got))
+	}
+}
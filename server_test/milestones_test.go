@@ -0,0 +1,314 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type milestoneTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	tool      string
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+func addMilestoneTestData(mock *MockGiteaServer) {
+	mock.AddMilestones("testuser", "testrepo", []MockMilestone{
+		{ID: 201, Title: "v1.0", Description: "First release", State: "closed", DueDate: "2025-06-30T00:00:00Z"},
+		{ID: 202, Title: "v1.1", State: "open"},
+		{ID: 203, Title: "v2.0", State: "open"},
+	})
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Created: "2025-09-10T09:00:00Z", Updated: "2025-09-10T09:00:00Z"},
+	})
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 10, Number: 5, Title: "Fix crash", State: "open", BaseRef: "main", UpdatedAt: "2025-09-12T10:30:00Z"},
+	})
+	mock.SetIssueMilestone("testuser", "testrepo", 5, 202)
+}
+
+func TestMilestones(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	milestoneV11 := map[string]any{"id": float64(202), "title": "v1.1", "state": "open", "open_issues": float64(0), "closed_issues": float64(0)}
+	testCases := []milestoneTestCase{
+		{
+			name:      "list open milestones",
+			setupMock: addMilestoneTestData,
+			tool:      "milestone_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 milestones"},
+				},
+				StructuredContent: map[string]any{
+					"milestones": []any{
+						milestoneV11,
+						map[string]any{"id": float64(203), "title": "v2.0", "state": "open", "open_issues": float64(0), "closed_issues": float64(0)},
+					},
+					"total":  float64(2),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "list closed milestones",
+			setupMock: addMilestoneTestData,
+			tool:      "milestone_list",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"state":      "closed",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 milestones"},
+				},
+				StructuredContent: map[string]any{
+					"milestones": []any{
+						map[string]any{"id": float64(201), "title": "v1.0", "description": "First release", "state": "closed", "open_issues": float64(0), "closed_issues": float64(0), "due_date": "2025-06-30T00:00:00Z"},
+					},
+					"total":  float64(1),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "create milestone with due date",
+			setupMock: addMilestoneTestData,
+			tool:      "milestone_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "v3.0",
+				"due_date":   "2026-03-31",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Milestone created successfully: v3.0"},
+				},
+				StructuredContent: map[string]any{
+					"milestone": map[string]any{"id": float64(1), "title": "v3.0", "state": "open", "open_issues": float64(0), "closed_issues": float64(0), "due_date": "2026-03-31T00:00:00Z"},
+				},
+			},
+		},
+		{
+			name: "error: invalid due date",
+			tool: "milestone_create",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "v3.0",
+				"due_date":   "next week",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: due_date: due date must be YYYY-MM-DD or RFC 3339."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "close milestone by title",
+			setupMock: addMilestoneTestData,
+			tool:      "milestone_edit",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "V1.1",
+				"state":      "closed",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Milestone edited successfully: v1.1 (closed)"},
+				},
+				StructuredContent: map[string]any{
+					"milestone": map[string]any{"id": float64(202), "title": "v1.1", "state": "closed", "open_issues": float64(0), "closed_issues": float64(0)},
+				},
+			},
+		},
+		{
+			name:      "error: unknown milestone",
+			setupMock: addMilestoneTestData,
+			tool:      "milestone_edit",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "v9.9",
+				"new_title":  "v10",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to edit milestone: failed to edit milestone: milestone not found in testuser/testrepo: v9.9"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: edit without changes",
+			tool: "milestone_edit",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"title":      "v1.1",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "At least one of new_title, description, due_date, or state must be provided"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "set issue milestone",
+			setupMock: addMilestoneTestData,
+			tool:      "issue_edit",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"milestone":    "v1.1",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Issue edited successfully. Number: 1, Title: Crash on start, State: open"},
+				},
+				StructuredContent: map[string]any{
+					"issue": map[string]any{
+						"id":        float64(1),
+						"number":    float64(1),
+						"title":     "Crash on start",
+						"state":     "open",
+						"user":      "testuser",
+						"created":   "2025-09-10T09:00:00Z",
+						"updated":   "2025-10-06T12:00:00Z",
+						"milestone": milestoneV11,
+					},
+				},
+			},
+		},
+		{
+			name: "error: milestone and clear_milestone",
+			tool: "issue_edit",
+			arguments: map[string]any{
+				"repository":      "testuser/testrepo",
+				"issue_number":    1,
+				"milestone":       "v1.1",
+				"clear_milestone": true,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: milestone: only one of milestone or clear_milestone may be set."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "clear pull request milestone",
+			setupMock: addMilestoneTestData,
+			tool:      "pr_edit",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"clear_milestone":     true,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Pull request edited successfully. Number: 5, Title: Fix crash, State: open, Updated: 2025-09-12T10:30:00Z\n"},
+				},
+				StructuredContent: map[string]any{
+					"pull_request": map[string]any{
+						"id":      float64(10),
+						"number":  float64(5),
+						"title":   "Fix crash",
+						"body":    "",
+						"state":   "open",
+						"user":    "testuser",
+						"created": "2025-09-11T10:30:00Z",
+						"updated": "2025-09-12T10:30:00Z",
+						"head":    map[string]any{"ref": "feature-branch", "sha": "abc123"},
+						"base":    map[string]any{"ref": "main", "sha": "def456"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+func TestPRMilestoneSet(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	addMilestoneTestData(mock)
+
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name: "pr_edit",
+		Arguments: map[string]any{
+			"repository":          "testuser/testrepo",
+			"pull_request_number": 5,
+			"title":               "Fix crash on start",
+			"milestone":           "v2.0",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call pr_edit tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("pr_edit failed: %s", GetTextContent(result.Content))
+	}
+
+	if got := mock.IssueMilestone("testuser", "testrepo", 5); got != 203 {
+		t.Errorf("expected milestone 203 on pull request, got %d", got)
+	}
+	pr, _ := GetStructuredContent(result)["pull_request"].(map[string]any)
+	if pr["title"] != "Fix crash on start" {
+		t.Errorf("expected title to be updated, got %v", pr["title"])
+	}
+	milestone, _ := pr["milestone"].(map[string]any)
+	if milestone["title"] != "v2.0" {
+		t.Errorf("expected milestone v2.0 in result, got %v", pr["milestone"])
+	}
+}
@@ -0,0 +1,196 @@
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addNotificationDigestTestData(mock *MockGiteaServer) {
+	mock.AddNotifications([]MockNotification{
+		{ID: 1, Repository: "testuser/testrepo", Type: "issue", Number: 1, Title: "Crash on start", Unread: true, Updated: "2025-10-14T09:00:00Z", URL: "https://example.com/api/v1/repos/testuser/testrepo/issues/1", HTMLURL: "https://example.com/testuser/testrepo/issues/1"},
+		{
+			ID: 2, Repository: "testuser/testrepo", Type: "pull", Number: 5, Title: "Fix crash", Unread: true, Updated: "2025-10-13T09:00:00Z",
+			URL:              "https://example.com/api/v1/repos/testuser/testrepo/pulls/5",
+			HTMLURL:          "https://example.com/testuser/testrepo/pulls/5",
+			LatestCommentURL: mock.URL() + "/api/v1/repos/testuser/testrepo/issues/comments/300",
+			State:            "open",
+		},
+		{ID: 3, Repository: "testuser/other", Type: "issue", Number: 7, Title: "Docs typo", Unread: true, Updated: "2025-10-15T09:00:00Z", URL: "https://example.com/api/v1/repos/testuser/other/issues/7"},
+		{ID: 4, Repository: "testuser/testrepo", Type: "issue", Number: 1, Title: "Already read", Unread: false, Updated: "2025-10-16T09:00:00Z"},
+	})
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 1, Title: "Crash on start", State: "open", Created: "2025-10-10T09:00:00Z", Updated: "2025-10-14T09:00:00Z"},
+	})
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 10, Number: 5, Title: "Fix crash", State: "open", BaseRef: "main", UpdatedAt: "2025-10-13T09:00:00Z"},
+	})
+	mock.AddComments("testuser", "testrepo", []MockComment{
+		{ID: 300, Issue: 5, Author: "alice", Content: "Tests pass locally.\n\n@testuser could you   take a look?", Created: "2025-10-13T09:00:00Z", Updated: "2025-10-13T09:00:00Z"},
+	})
+	mock.SetAssignees("testuser", "testrepo", 1, "testuser")
+	mock.SetReviewRequests("testuser", "testrepo", 5, "testuser")
+	mock.AddCommitStatuses("testuser", "testrepo", "abc123", []MockCommitStatus{
+		{ID: 1, Context: "ci/build", State: "success"},
+		{ID: 2, Context: "ci/test", State: "failure"},
+	})
+}
+
+func TestNotificationDigest(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	pullItem := map[string]any{
+		"id": float64(2), "repository": "testuser/testrepo", "type": "pull", "number": float64(5), "title": "Fix crash",
+		"state": "open", "html_url": "https://example.com/testuser/testrepo/pulls/5",
+		"reason": "review_requested", "reasons": []any{"review_requested", "mention", "ci"}, "priority": float64(1),
+		"latest_comment_author": "alice", "latest_comment_excerpt": "Tests pass locally. @testuser could you take a look?",
+		"ci_state": "failure", "updated": "2025-10-13T09:00:00Z",
+	}
+	issueItem := map[string]any{
+		"id": float64(1), "repository": "testuser/testrepo", "type": "issue", "number": float64(1), "title": "Crash on start",
+		"state": "open", "html_url": "https://example.com/testuser/testrepo/issues/1", "reason": "assigned", "reasons": []any{"assigned"}, "priority": float64(3), "updated": "2025-10-14T09:00:00Z",
+	}
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		setupMock  func(*MockGiteaServer)
+		expect     *mcp.CallToolResult
+	}{
+		{
+			name:       "digest across repositories (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 3 unread notifications in 3 groups"},
+				},
+				StructuredContent: map[string]any{
+					"items": []any{
+						pullItem,
+						issueItem,
+						map[string]any{
+							"id": float64(3), "repository": "testuser/other", "type": "issue", "number": float64(7), "title": "Docs typo",
+							"reason": "subscribed", "reasons": []any{"subscribed"}, "priority": float64(5), "updated": "2025-10-15T09:00:00Z",
+						},
+					},
+					"groups": []any{
+						map[string]any{"repository": "testuser/testrepo", "reason": "review_requested", "count": float64(1), "notification_ids": []any{float64(2)}},
+						map[string]any{"repository": "testuser/testrepo", "reason": "assigned", "count": float64(1), "notification_ids": []any{float64(1)}},
+						map[string]any{"repository": "testuser/other", "reason": "subscribed", "count": float64(1), "notification_ids": []any{float64(3)}},
+					},
+					"total":    float64(3),
+					"returned": float64(3),
+				},
+			},
+		},
+		{
+			name:       "digest for one repository (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "limit": 1},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 unread notifications, showing the first 1 in 1 groups"},
+				},
+				StructuredContent: map[string]any{
+					"items": []any{pullItem},
+					"groups": []any{
+						map[string]any{"repository": "testuser/testrepo", "reason": "review_requested", "count": float64(1), "notification_ids": []any{float64(2)}},
+					},
+					"total":    float64(2),
+					"returned": float64(1),
+				},
+			},
+		},
+		{
+			name:       "ranks notifications beyond the first page (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"limit": 1},
+			setupMock: func(mock *MockGiteaServer) {
+				// Newer notifications listed ahead of the review request fill the first page
+				var notifications []MockNotification
+				for i := range 60 {
+					notifications = append(notifications, MockNotification{
+						ID: 100 + i, Repository: "testuser/other", Type: "issue", Number: 100 + i, Title: fmt.Sprintf("Watched issue %d", i),
+						Unread: true, Updated: "2025-10-16T09:00:00Z", URL: fmt.Sprintf("https://example.com/api/v1/repos/testuser/other/issues/%d", 100+i),
+					})
+				}
+				mock.AddNotifications(notifications)
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 63 unread notifications, showing the first 1 in 1 groups"},
+				},
+				StructuredContent: map[string]any{
+					"items": []any{pullItem},
+					"groups": []any{
+						map[string]any{"repository": "testuser/testrepo", "reason": "review_requested", "count": float64(1), "notification_ids": []any{float64(2)}},
+					},
+					"total":    float64(63),
+					"returned": float64(1),
+				},
+			},
+		},
+		{
+			name:      "error: limit too large",
+			arguments: map[string]any{"limit": 100},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: limit: must be no greater than 50."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: invalid repository",
+			arguments: map[string]any{"repository": "testrepo"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: repository: repository must be in format 'owner/repo'."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+			addNotificationDigestTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "notification_digest",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call notification_digest tool: %v", err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
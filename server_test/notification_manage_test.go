@@ -0,0 +1,219 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addNotificationManageTestData(mock *MockGiteaServer) {
+	mock.AddNotifications([]MockNotification{
+		{ID: 1, Repository: "testuser/testrepo", Type: "issue", Number: 123, Title: "New issue created", Unread: true, Updated: "2025-10-16T10:00:00Z", URL: "https://example.com/testuser/testrepo/issues/123"},
+		{ID: 2, Repository: "testuser/testrepo", Type: "pull", Number: 456, Title: "PR review requested", Unread: true, Updated: "2025-10-16T11:00:00Z", URL: "https://example.com/testuser/testrepo/pulls/456"},
+		{ID: 3, Repository: "testuser/other", Type: "issue", Number: 7, Title: "Other repository", Unread: true, Updated: "2025-10-16T12:00:00Z", URL: "https://example.com/testuser/other/issues/7"},
+	})
+	mock.AddIssues("testuser", "testrepo", []MockIssue{
+		{Index: 123, Title: "New issue created", State: "open", Created: "2025-10-16T10:00:00Z", Updated: "2025-10-16T10:00:00Z"},
+	})
+}
+
+func TestNotificationManage(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name        string
+		clientType  string
+		tool        string
+		arguments   map[string]any
+		expect      *mcp.CallToolResult
+		unread      []int
+		pinned      []int
+		subscribers []string
+	}{
+		{
+			name:       "mark notification read (gitea)",
+			clientType: "gitea",
+			tool:       "notification_mark",
+			arguments:  map[string]any{"notification_id": 2},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Marked notification 2 as read"},
+				},
+				StructuredContent: map[string]any{
+					"notifications": []any{
+						map[string]any{"id": float64(2), "repository": "testuser/testrepo", "type": "pull", "number": float64(456), "title": "PR review requested", "unread": false, "updated": "2025-10-16T11:00:00Z"},
+					},
+					"status": "read",
+				},
+			},
+			unread: []int{1, 3},
+		},
+		{
+			name:       "pin notification (forgejo)",
+			clientType: "forgejo",
+			tool:       "notification_mark",
+			arguments:  map[string]any{"notification_id": 1, "status": "pinned"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Marked notification 1 as pinned"},
+				},
+				StructuredContent: map[string]any{
+					"notifications": []any{
+						map[string]any{"id": float64(1), "repository": "testuser/testrepo", "type": "issue", "number": float64(123), "title": "New issue created", "unread": false, "pinned": true, "updated": "2025-10-16T10:00:00Z"},
+					},
+					"status": "pinned",
+				},
+			},
+			unread: []int{2, 3},
+			pinned: []int{1},
+		},
+		{
+			name:       "mark repository notifications read (forgejo)",
+			clientType: "forgejo",
+			tool:       "notification_mark",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Marked 2 notifications in testuser/testrepo as read"},
+				},
+				StructuredContent: map[string]any{
+					"notifications": []any{
+						map[string]any{"id": float64(1), "repository": "testuser/testrepo", "type": "issue", "number": float64(123), "title": "New issue created", "unread": false, "updated": "2025-10-16T10:00:00Z"},
+						map[string]any{"id": float64(2), "repository": "testuser/testrepo", "type": "pull", "number": float64(456), "title": "PR review requested", "unread": false, "updated": "2025-10-16T11:00:00Z"},
+					},
+					"status": "read",
+				},
+			},
+			unread: []int{3},
+		},
+		{
+			name:       "error: unknown notification",
+			clientType: "gitea",
+			tool:       "notification_mark",
+			arguments:  map[string]any{"notification_id": 99},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to mark notification: failed to mark notification: notification does not exist"},
+				},
+				IsError: true,
+			},
+			unread: []int{1, 2, 3},
+		},
+		{
+			name:      "error: repository with unread status",
+			tool:      "notification_mark",
+			arguments: map[string]any{"repository": "testuser/testrepo", "status": "unread"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: status: only status 'read' is supported when marking a repository."},
+				},
+				IsError: true,
+			},
+			unread: []int{1, 2, 3},
+		},
+		{
+			name:      "error: notification id and repository",
+			tool:      "notification_mark",
+			arguments: map[string]any{"notification_id": 1, "repository": "testuser/testrepo"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: repository: only one of notification_id or repository may be set."},
+				},
+				IsError: true,
+			},
+			unread: []int{1, 2, 3},
+		},
+		{
+			name:       "subscribe to issue (gitea)",
+			clientType: "gitea",
+			tool:       "notification_subscribe",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "issue_number": 123},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Subscribed to notifications for #123"},
+				},
+				StructuredContent: map[string]any{"issue_number": float64(123), "subscribed": true},
+			},
+			unread:      []int{1, 2, 3},
+			subscribers: []string{"testuser"},
+		},
+		{
+			name:       "unsubscribe from issue (forgejo)",
+			clientType: "forgejo",
+			tool:       "notification_unsubscribe",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "issue_number": 123},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Unsubscribed from notifications for #123"},
+				},
+				StructuredContent: map[string]any{"issue_number": float64(123), "subscribed": false},
+			},
+			unread: []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addNotificationManageTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			if tc.tool == "notification_unsubscribe" {
+				if _, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+					Name:      "notification_subscribe",
+					Arguments: tc.arguments,
+				}); err != nil {
+					t.Fatalf("Failed to call notification_subscribe tool: %v", err)
+				}
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+
+			var unread, pinned []int
+			for _, n := range mock.Notifications() {
+				if n.Unread {
+					unread = append(unread, n.ID)
+				}
+				if n.Pinned {
+					pinned = append(pinned, n.ID)
+				}
+			}
+			if !cmp.Equal(tc.unread, unread) {
+				t.Errorf("unread notifications: %s", cmp.Diff(tc.unread, unread))
+			}
+			if !cmp.Equal(tc.pinned, pinned) {
+				t.Errorf("pinned notifications: %s", cmp.Diff(tc.pinned, pinned))
+			}
+			if got := mock.Subscribers("testuser", "testrepo", 123); !cmp.Equal(tc.subscribers, got, cmpopts.EquateEmpty()) {
+				t.Errorf("subscribers: %s", cmp.Diff(tc.subscribers, got, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
@@ -122,7 +122,8 @@ func TestPullRequestCreateSuccess(t *testing.T) {
 		{
 			name: "successful PR creation with all parameters",
 			setupMock: func(mock *MockGiteaServer) {
-				// No existing PRs needed for creation
+				// Assignees must be collaborators on the repository
+				mock.AddCollaborators("testuser", "testrepo", "reviewer")
 			},
 			arguments: map[string]any{
 				"repository": "testuser/testrepo",
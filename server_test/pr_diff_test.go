@@ -0,0 +1,292 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type prDiffTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	tool      string
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+const (
+	testDiffMain = "diff --git a/main.go b/main.go\n" +
+		"index 1111111..2222222 100644\n" +
+		"--- a/main.go\n" +
+		"+++ b/main.go\n" +
+		"@@ -1,3 +1,4 @@\n" +
+		" package main\n" +
+		"+import \"fmt\"\n" +
+		" func main() {\n" +
+		" }\n"
+	testDiffDocs = "diff --git a/docs/guide.md b/docs/guide.md\n" +
+		"new file mode 100644\n" +
+		"--- /dev/null\n" +
+		"+++ b/docs/guide.md\n" +
+		"@@ -0,0 +1 @@\n" +
+		"+# Guide\n"
+	testDiffUtil = "diff --git a/util.go b/util.go\n" +
+		"deleted file mode 100644\n" +
+		"--- a/util.go\n" +
+		"+++ /dev/null\n" +
+		"@@ -1 +0,0 @@\n" +
+		"-package main\n"
+	testDiffSpaced = "diff --git a/notes/a b/c.md b/notes/a b/c.md\n" +
+		"index 3333333..4444444 100644\n" +
+		"--- a/notes/a b/c.md\t\n" +
+		"+++ b/notes/a b/c.md\t\n" +
+		"@@ -1 +1 @@\n" +
+		"-old\n" +
+		"+new\n"
+	testDiffRenamed = "diff --git a/lib/old.go b/lib/new.go\n" +
+		"similarity index 90%\n" +
+		"rename from lib/old.go\n" +
+		"rename to lib/new.go\n" +
+		"--- a/lib/old.go\n" +
+		"+++ b/lib/new.go\n" +
+		"@@ -1 +1 @@\n" +
+		"-package old\n" +
+		"+package lib\n"
+	testDiffQuoted = "diff --git \"a/caf\\303\\251.txt\" \"b/caf\\303\\251.txt\"\n" +
+		"new file mode 100644\n" +
+		"--- /dev/null\n" +
+		"+++ \"b/caf\\303\\251.txt\"\n" +
+		"@@ -0,0 +1 @@\n" +
+		"+menu\n"
+)
+
+func addDiffTestData(mock *MockGiteaServer) {
+	mock.AddPullRequestDiff("testuser", "testrepo", 1, testDiffMain+testDiffDocs+testDiffUtil)
+	mock.AddPullRequestFiles("testuser", "testrepo", 1, []MockChangedFile{
+		{Filename: "main.go", Status: "modified", Additions: 1},
+		{Filename: "docs/guide.md", Status: "added", Additions: 1},
+		{Filename: "util.go", Status: "deleted", Deletions: 1},
+		{Filename: "lib/new.go", PreviousFilename: "lib/old.go", Status: "renamed"},
+	})
+}
+
+func TestPullRequestDiff(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []prDiffTestCase{
+		{
+			name:      "full diff",
+			setupMock: addDiffTestData,
+			tool:      "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Diff of 3 of 3 files in pull request #1"},
+				},
+				StructuredContent: map[string]any{
+					"diff": testDiffMain + testDiffDocs + testDiffUtil,
+					"files": []any{
+						map[string]any{"path": "main.go", "lines": float64(9), "truncated": false},
+						map[string]any{"path": "docs/guide.md", "lines": float64(6), "truncated": false},
+						map[string]any{"path": "util.go", "lines": float64(6), "truncated": false},
+					},
+					"total":  float64(3),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "filter by directory and glob",
+			setupMock: addDiffTestData,
+			tool:      "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"paths":               []string{"docs/", "util.*"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Diff of 2 of 2 files in pull request #1"},
+				},
+				StructuredContent: map[string]any{
+					"diff": testDiffDocs + testDiffUtil,
+					"files": []any{
+						map[string]any{"path": "docs/guide.md", "lines": float64(6), "truncated": false},
+						map[string]any{"path": "util.go", "lines": float64(6), "truncated": false},
+					},
+					"total":  float64(2),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name: "paths with spaces, renames, and quoted names",
+			setupMock: func(mock *MockGiteaServer) {
+				mock.AddPullRequestDiff("testuser", "testrepo", 1, testDiffSpaced+testDiffRenamed+testDiffQuoted)
+			},
+			tool: "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"paths":               []string{"notes/a b/c.md", "lib/new.go", "café.txt"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Diff of 3 of 3 files in pull request #1"},
+				},
+				StructuredContent: map[string]any{
+					"diff": testDiffSpaced + testDiffRenamed + testDiffQuoted,
+					"files": []any{
+						map[string]any{"path": "notes/a b/c.md", "lines": float64(7), "truncated": false},
+						map[string]any{"path": "lib/new.go", "lines": float64(9), "truncated": false},
+						map[string]any{"path": "café.txt", "lines": float64(6), "truncated": false},
+					},
+					"total":  float64(3),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "paginated and truncated",
+			setupMock: addDiffTestData,
+			tool:      "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"limit":               1,
+				"max_lines_per_file":  5,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Diff of 1 of 3 files in pull request #1"},
+				},
+				StructuredContent: map[string]any{
+					"diff": "diff --git a/main.go b/main.go\n" +
+						"index 1111111..2222222 100644\n" +
+						"--- a/main.go\n" +
+						"+++ b/main.go\n" +
+						"@@ -1,3 +1,4 @@\n" +
+						"... [truncated 4 more lines of main.go] ...\n" +
+						"... [2 more files not shown, use offset 1 to continue] ...\n",
+					"files": []any{
+						map[string]any{"path": "main.go", "lines": float64(9), "truncated": true},
+					},
+					"total":  float64(3),
+					"limit":  float64(1),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "error: diff not found",
+			setupMock: addDiffTestData,
+			tool:      "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to get pull request diff: failed to get pull request diff: pull request does not exist"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid path pattern",
+			tool: "pr_diff",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"paths":               []string{"[main.go"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: paths: (0: invalid path pattern.)."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "list changed files",
+			setupMock: addDiffTestData,
+			tool:      "pr_files",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"limit":               2,
+				"offset":              2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 changed files"},
+				},
+				StructuredContent: map[string]any{
+					"files": []any{
+						map[string]any{"filename": "util.go", "status": "deleted", "additions": float64(0), "deletions": float64(1), "changes": float64(1)},
+						map[string]any{"filename": "lib/new.go", "previous_filename": "lib/old.go", "status": "renamed", "additions": float64(0), "deletions": float64(0), "changes": float64(0)},
+					},
+					"total":  float64(4),
+					"limit":  float64(2),
+					"offset": float64(2),
+				},
+			},
+		},
+		{
+			name: "error: files limit out of range",
+			tool: "pr_files",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"limit":               500,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: limit: must be no greater than 100."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
@@ -218,7 +218,7 @@ func TestEditPullRequest(t *testing.T) {
 			},
 			expect: &mcp.CallToolResult{
 				Content: []mcp.Content{
-					&mcp.TextContent{Text: "At least one of title, body, state, or base_branch must be provided"},
+					&mcp.TextContent{Text: "At least one of title, body, state, base_branch, milestone, clear_milestone, assignees, or clear_assignees must be provided"},
 				},
 				StructuredContent: nil,
 				IsError:           true,
@@ -0,0 +1,266 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type prMergeTestCase struct {
+	name        string
+	setupMock   func(*MockGiteaServer)
+	arguments   map[string]any
+	expect      *mcp.CallToolResult
+	expectMerge map[string]any // expected merge request body sent to the remote, nil if no request
+}
+
+func addMergeTestPullRequests(mock *MockGiteaServer) {
+	mock.AddPullRequests("testuser", "testrepo", []MockPullRequest{
+		{ID: 1, Number: 1, Title: "Open PR", State: "open", BaseRef: "main", UpdatedAt: "2025-09-11T10:30:00Z"},
+		{ID: 2, Number: 2, Title: "Closed PR", State: "closed", BaseRef: "main", UpdatedAt: "2025-09-11T10:30:00Z"},
+	})
+}
+
+func TestMergePullRequest(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []prMergeTestCase{
+		{
+			name:      "default merge style",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Pull request #1 merged"},
+				},
+				StructuredContent: map[string]any{
+					"merge": map[string]any{
+						"merged":    true,
+						"scheduled": false,
+						"style":     "merge",
+					},
+				},
+			},
+			expectMerge: map[string]any{
+				"Do":                        "merge",
+				"MergeCommitID":             "",
+				"MergeTitleField":           "",
+				"MergeMessageField":         "",
+				"delete_branch_after_merge": false,
+				"force_merge":               false,
+				"head_commit_id":            "",
+				"merge_when_checks_succeed": false,
+			},
+		},
+		{
+			name:      "squash with custom message and branch deletion",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"style":               "squash",
+				"title":               "Add feature (#1)",
+				"message":             "Squashed commits",
+				"delete_branch":       true,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Pull request #1 merged"},
+				},
+				StructuredContent: map[string]any{
+					"merge": map[string]any{
+						"merged":    true,
+						"scheduled": false,
+						"style":     "squash",
+					},
+				},
+			},
+			expectMerge: map[string]any{
+				"Do":                        "squash",
+				"MergeCommitID":             "",
+				"MergeTitleField":           "Add feature (#1)",
+				"MergeMessageField":         "Squashed commits",
+				"delete_branch_after_merge": true,
+				"force_merge":               false,
+				"head_commit_id":            "",
+				"merge_when_checks_succeed": false,
+			},
+		},
+		{
+			name:      "fast-forward-only style",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"style":               "fast-forward-only",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Pull request #1 merged"},
+				},
+				StructuredContent: map[string]any{
+					"merge": map[string]any{
+						"merged":    true,
+						"scheduled": false,
+						"style":     "fast-forward-only",
+					},
+				},
+			},
+			expectMerge: map[string]any{
+				"Do":                        "fast-forward-only",
+				"MergeCommitID":             "",
+				"MergeTitleField":           "",
+				"MergeMessageField":         "",
+				"delete_branch_after_merge": false,
+				"force_merge":               false,
+				"head_commit_id":            "",
+				"merge_when_checks_succeed": false,
+			},
+		},
+		{
+			name:      "merge when checks succeed",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":                "testuser/testrepo",
+				"pull_request_number":       1,
+				"style":                     "rebase",
+				"merge_when_checks_succeed": true,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Pull request #1 scheduled to merge"},
+				},
+				StructuredContent: map[string]any{
+					"merge": map[string]any{
+						"merged":    false,
+						"scheduled": true,
+						"style":     "rebase",
+					},
+				},
+			},
+			expectMerge: map[string]any{
+				"Do":                        "rebase",
+				"MergeCommitID":             "",
+				"MergeTitleField":           "",
+				"MergeMessageField":         "",
+				"delete_branch_after_merge": false,
+				"force_merge":               false,
+				"head_commit_id":            "",
+				"merge_when_checks_succeed": true,
+			},
+		},
+		{
+			name:      "error: pull request not mergeable",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 2,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to merge pull request: pull request #2 is not mergeable (already merged, closed, conflicting, or blocked by branch protection)"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: pull request not found",
+			setupMock: addMergeTestPullRequests,
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 99,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to merge pull request: pull request #99 not found in testuser/testrepo"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid style",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"style":               "octopus",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: style: style must be 'merge', 'rebase', 'rebase-merge', 'squash', or 'fast-forward-only'."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: message with fast-forward-only",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"style":               "fast-forward-only",
+				"message":             "ignored",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: message: message is not supported with fast-forward-only merges."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: missing repository and directory",
+			arguments: map[string]any{
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: directory: at least one of directory or repository must be provided; repository: at least one of directory or repository must be provided."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "pr_merge",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call pr_merge tool: %v", err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+			if tc.expectMerge != nil {
+				number := tc.arguments["pull_request_number"].(int)
+				if diff := cmp.Diff(tc.expectMerge, mock.MergeOptions("testuser", "testrepo", number)); diff != "" {
+					t.Errorf("Merge request body mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type prReviewTestCase struct {
+	name      string
+	setupMock func(*MockGiteaServer)
+	tool      string
+	arguments map[string]any
+	expect    *mcp.CallToolResult
+}
+
+func addTestReviews(mock *MockGiteaServer) {
+	mock.AddReviews("testuser", "testrepo", 1, []MockReview{
+		{ID: 10, Reviewer: "alice", State: "APPROVED", Body: "LGTM"},
+		{
+			ID: 11, Reviewer: "bob", State: "REQUEST_CHANGES", Body: "Needs work",
+			Comments: []MockReviewComment{
+				{ID: 20, Path: "main.go", Body: "Handle this error", Line: 42},
+				{ID: 21, Path: "util.go", Body: "Why was this removed?", OldLine: 7},
+			},
+		},
+	})
+}
+
+func TestPullRequestReviews(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []prReviewTestCase{
+		{
+			name: "create review with inline comments",
+			tool: "pr_review_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"event":               "REQUEST_CHANGES",
+				"body":                "A few issues",
+				"comments": []map[string]any{
+					{"path": "main.go", "body": "Check for nil", "new_line": 12},
+				},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Review 1 submitted on pull request #1 (REQUEST_CHANGES)"},
+				},
+				StructuredContent: map[string]any{
+					"review": map[string]any{
+						"id":             float64(1),
+						"reviewer":       "testuser",
+						"state":          "REQUEST_CHANGES",
+						"body":           "A few issues",
+						"commit_id":      "abc123",
+						"stale":          false,
+						"official":       true,
+						"dismissed":      false,
+						"comments_count": float64(1),
+						"submitted":      "2025-10-01T12:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name: "approve without body",
+			tool: "pr_review_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"event":               "APPROVE",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Review 1 submitted on pull request #1 (APPROVED)"},
+				},
+				StructuredContent: map[string]any{
+					"review": map[string]any{
+						"id":             float64(1),
+						"reviewer":       "testuser",
+						"state":          "APPROVED",
+						"commit_id":      "abc123",
+						"stale":          false,
+						"official":       true,
+						"dismissed":      false,
+						"comments_count": float64(0),
+						"submitted":      "2025-10-01T12:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name: "error: comment without body or inline comments",
+			tool: "pr_review_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"event":               "COMMENT",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: body: body is required unless approving or adding inline comments."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid event",
+			tool: "pr_review_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"event":               "REJECT",
+				"body":                "No",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: event: event must be 'APPROVE', 'REQUEST_CHANGES', or 'COMMENT'."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: inline comment anchored to both lines",
+			tool: "pr_review_create",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"event":               "COMMENT",
+				"comments": []map[string]any{
+					{"path": "main.go", "body": "Ambiguous", "new_line": 3, "old_line": 4},
+				},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: comments: (0: (new_line: only one of new_line or old_line may be set.).)."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "list reviews",
+			setupMock: addTestReviews,
+			tool:      "pr_review_list",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 reviews"},
+				},
+				StructuredContent: map[string]any{
+					"reviews": []any{
+						map[string]any{
+							"id":             float64(10),
+							"reviewer":       "alice",
+							"state":          "APPROVED",
+							"body":           "LGTM",
+							"commit_id":      "abc123",
+							"stale":          false,
+							"official":       true,
+							"dismissed":      false,
+							"comments_count": float64(0),
+							"submitted":      "2025-10-01T12:00:00Z",
+						},
+						map[string]any{
+							"id":             float64(11),
+							"reviewer":       "bob",
+							"state":          "REQUEST_CHANGES",
+							"body":           "Needs work",
+							"commit_id":      "abc123",
+							"stale":          false,
+							"official":       true,
+							"dismissed":      false,
+							"comments_count": float64(2),
+							"submitted":      "2025-10-01T12:00:00Z",
+						},
+					},
+					"total":  float64(2),
+					"limit":  float64(15),
+					"offset": float64(0),
+				},
+			},
+		},
+		{
+			name:      "list review comments",
+			setupMock: addTestReviews,
+			tool:      "pr_review_comments_list",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"review_id":           11,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 2 review comments"},
+				},
+				StructuredContent: map[string]any{
+					"comments": []any{
+						map[string]any{
+							"id":        float64(20),
+							"review_id": float64(11),
+							"body":      "Handle this error",
+							"user":      "bob",
+							"path":      "main.go",
+							"line":      float64(42),
+							"commit_id": "abc123",
+							"diff_hunk": "@@ -1,3 +1,3 @@",
+							"resolved":  false,
+							"created":   "2025-10-01T12:00:00Z",
+							"updated":   "2025-10-01T12:00:00Z",
+						},
+						map[string]any{
+							"id":        float64(21),
+							"review_id": float64(11),
+							"body":      "Why was this removed?",
+							"user":      "bob",
+							"path":      "util.go",
+							"old_line":  float64(7),
+							"commit_id": "abc123",
+							"diff_hunk": "@@ -1,3 +1,3 @@",
+							"resolved":  false,
+							"created":   "2025-10-01T12:00:00Z",
+							"updated":   "2025-10-01T12:00:00Z",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "error: review comments for unknown review",
+			setupMock: addTestReviews,
+			tool:      "pr_review_comments_list",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+				"review_id":           99,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to list review comments: failed to list pull request review comments: review does not exist"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: missing review id",
+			tool: "pr_review_comments_list",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: review_id: review ID is required."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+// TestPullRequestReviewCreate_StoresInlineComments verifies inline comments reach the remote with their anchors
+func TestPullRequestReviewCreate_StoresInlineComments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mock := NewMockGiteaServer(t)
+	ts := NewTestServer(t, ctx, map[string]string{
+		"FORGEJO_REMOTE_URL": mock.URL(),
+		"FORGEJO_AUTH_TOKEN": "mock-token",
+	})
+	if err := ts.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize test server: %v", err)
+	}
+
+	result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+		Name: "pr_review_create",
+		Arguments: map[string]any{
+			"repository":          "testuser/testrepo",
+			"pull_request_number": 5,
+			"event":               "COMMENT",
+			"comments": []map[string]any{
+				{"path": "a.go", "body": "New line note", "new_line": 10},
+				{"path": "b.go", "body": "Old line note", "old_line": 3},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call pr_review_create tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %s", GetTextContent(result.Content))
+	}
+
+	want := []MockReview{{
+		ID: 1, Reviewer: "testuser", State: "COMMENT",
+		Comments: []MockReviewComment{
+			{ID: 2, Path: "a.go", Body: "New line note", Line: 10},
+			{ID: 3, Path: "b.go", Body: "Old line note", OldLine: 3},
+		},
+	}}
+	if diff := cmp.Diff(want, mock.Reviews("testuser", "testrepo", 5)); diff != "" {
+		t.Errorf("Stored reviews mismatch (-want +got):\n%s", diff)
+	}
+}
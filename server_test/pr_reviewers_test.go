@@ -0,0 +1,163 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestPullRequestReviewers(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name      string
+		tool      string
+		arguments map[string]any
+		expect    *mcp.CallToolResult
+		requested []string
+	}{
+		{
+			name: "request users and team",
+			tool: "pr_reviewer_request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"reviewers":           []string{"bob"},
+				"team_reviewers":      []string{"core"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Requested review on pull request #5 from: bob, team core"},
+				},
+				StructuredContent: map[string]any{
+					"pull_request_number": float64(5),
+					"reviewers":           []any{"bob"},
+					"team_reviewers":      []any{"core"},
+				},
+			},
+			requested: []string{"alice", "bob", "team:core"},
+		},
+		{
+			name: "request team member",
+			tool: "pr_reviewer_request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"reviewers":           []string{"carol"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Requested review on pull request #5 from: carol"},
+				},
+				StructuredContent: map[string]any{
+					"pull_request_number": float64(5),
+					"reviewers":           []any{"carol"},
+				},
+			},
+			requested: []string{"alice", "carol"},
+		},
+		{
+			name: "remove reviewer",
+			tool: "pr_reviewer_remove",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"reviewers":           []string{"alice"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Removed review requests on pull request #5 for: alice"},
+				},
+				StructuredContent: map[string]any{
+					"pull_request_number": float64(5),
+					"reviewers":           []any{"alice"},
+				},
+			},
+			requested: []string{},
+		},
+		{
+			name: "error: unknown reviewer",
+			tool: "pr_reviewer_request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"reviewers":           []string{"bob", "ghost"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to request reviewers: failed to request reviewers: users not found: ghost"},
+				},
+				IsError: true,
+			},
+			requested: []string{"alice"},
+		},
+		{
+			name: "error: outsider reviewer",
+			tool: "pr_reviewer_request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+				"reviewers":           []string{"mallory"},
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to request reviewers: failed to request reviewers: users without read access to testuser/testrepo: mallory"},
+				},
+				IsError: true,
+			},
+			requested: []string{"alice"},
+		},
+		{
+			name: "error: no reviewers",
+			tool: "pr_reviewer_request",
+			arguments: map[string]any{
+				"repository":          "testuser/testrepo",
+				"pull_request_number": 5,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: reviewers: at least one of reviewers or team_reviewers must be provided."},
+				},
+				IsError: true,
+			},
+			requested: []string{"alice"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addAssigneeTestData(mock)
+			mock.SetReviewRequests("testuser", "testrepo", 5, "alice")
+
+			ts := NewTestServer(t, ctx, map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			})
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+			if got := mock.ReviewRequests("testuser", "testrepo", 5); !cmp.Equal(tc.requested, got, cmpopts.EquateEmpty()) {
+				t.Error(cmp.Diff(tc.requested, got, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
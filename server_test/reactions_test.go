@@ -0,0 +1,246 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addReactionTestData(mock *MockGiteaServer) {
+	mock.AddIssueReactions("testuser", "testrepo", 1,
+		MockReaction{User: "alice", Content: "+1"},
+		MockReaction{User: "bob", Content: "eyes"},
+		MockReaction{User: "testuser", Content: "+1"},
+	)
+	mock.AddComments("testuser", "testrepo", []MockComment{
+		{ID: 101, Content: "Looking into it", Author: "alice", Created: "2025-09-10T10:00:00Z", Updated: "2025-09-10T10:00:00Z", Issue: 1},
+	})
+	mock.AddCommentReactions("testuser", "testrepo", 101, MockReaction{User: "bob", Content: "heart"})
+}
+
+func TestReactions(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		tool       string
+		arguments  map[string]any
+		expect     *mcp.CallToolResult
+		setupMock  func(*MockGiteaServer)
+		issue      []MockReaction
+		comment    []MockReaction
+	}{
+		{
+			name:       "list issue reactions (gitea)",
+			clientType: "gitea",
+			tool:       "reaction_list",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 3 reactions"},
+				},
+				StructuredContent: map[string]any{
+					"reactions": []any{
+						map[string]any{"content": "+1", "count": float64(2), "users": []any{"alice", "testuser"}},
+						map[string]any{"content": "eyes", "count": float64(1), "users": []any{"bob"}},
+					},
+				},
+			},
+		},
+		{
+			name:       "add comment reaction by emoji (forgejo)",
+			clientType: "forgejo",
+			tool:       "reaction_add",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"comment_id": 101,
+				"content":    "👀",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Added eyes reaction to comment 101"},
+				},
+				StructuredContent: map[string]any{"comment_id": float64(101), "content": "eyes"},
+			},
+			comment: []MockReaction{{User: "bob", Content: "heart"}, {User: "testuser", Content: "eyes"}},
+		},
+		{
+			name:       "remove issue reaction (gitea)",
+			clientType: "gitea",
+			tool:       "reaction_remove",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"content":      "+1",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Removed +1 reaction from #1"},
+				},
+				StructuredContent: map[string]any{"issue_number": float64(1), "content": "+1"},
+			},
+			issue: []MockReaction{{User: "alice", Content: "+1"}, {User: "bob", Content: "eyes"}},
+		},
+		{
+			name:       "error: reaction not allowed (forgejo)",
+			clientType: "forgejo",
+			tool:       "reaction_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"content":      "party",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to add reaction: failed to add reaction: 'party' is not an allowed reaction"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: issue number and comment id",
+			tool: "reaction_add",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+				"comment_id":   101,
+				"content":      "+1",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: issue_number: only one of issue_number or comment_id may be set."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name: "error: invalid reaction name",
+			tool: "reaction_remove",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"comment_id": 101,
+				"content":    "thumbs up",
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: content: content must be a reaction name such as '+1' or 'eyes'."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:       "comment list includes reactions (forgejo)",
+			clientType: "forgejo",
+			tool:       "issue_comment_list",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 comments"},
+				},
+				StructuredContent: map[string]any{
+					"comments": []any{
+						map[string]any{
+							"id":      float64(101),
+							"body":    "Looking into it",
+							"user":    "alice",
+							"created": "2025-09-10T10:00:00Z",
+							"updated": "2025-09-10T10:00:00Z",
+							"reactions": []any{
+								map[string]any{"content": "heart", "count": float64(1), "users": []any{"bob"}},
+							},
+						},
+					},
+					"total": float64(1),
+					"limit": float64(15),
+				},
+			},
+		},
+		{
+			name:       "comment list without reactions that fail to load (gitea)",
+			clientType: "gitea",
+			tool:       "issue_comment_list",
+			arguments: map[string]any{
+				"repository":   "testuser/testrepo",
+				"issue_number": 1,
+			},
+			setupMock: func(mock *MockGiteaServer) {
+				mock.SetServerErrorCommentReactions(101)
+			},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Found 1 comments"},
+				},
+				StructuredContent: map[string]any{
+					"comments": []any{
+						map[string]any{
+							"id":      float64(101),
+							"body":    "Looking into it",
+							"user":    "alice",
+							"created": "2025-09-10T10:00:00Z",
+							"updated": "2025-09-10T10:00:00Z",
+						},
+					},
+					"total": float64(1),
+					"limit": float64(15),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReactionTestData(mock)
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      tc.tool,
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call %s tool: %v", tc.tool, err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+			if tc.issue != nil {
+				if got := mock.IssueReactions("testuser", "testrepo", 1); !cmp.Equal(tc.issue, got) {
+					t.Error(cmp.Diff(tc.issue, got))
+				}
+			}
+			if tc.comment != nil {
+				if got := mock.CommentReactions("testuser", "testrepo", 101); !cmp.Equal(tc.comment, got) {
+					t.Error(cmp.Diff(tc.comment, got))
+				}
+			}
+		})
+	}
+}
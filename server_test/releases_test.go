@@ -0,0 +1,522 @@
+package servertest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addReleaseTestData(mock *MockGiteaServer) {
+	mock.AddBranches("testuser", "testrepo", []MockBranch{
+		{Name: "main", Commits: []MockCommit{branchTestCommit("1", "Initial commit"), branchTestCommit("2", "Add feature")}},
+	})
+	mock.AddTags("testuser", "testrepo", []MockTag{
+		{Name: "v1.0.0", CommitSHA: branchTestCommit("1", "").SHA},
+		{Name: "v1.1.0-rc1", CommitSHA: branchTestCommit("2", "").SHA},
+	})
+	mock.AddReleases("testuser", "testrepo", []MockRelease{
+		{
+			ID: 900, TagName: "v1.0.0", Target: "main", Name: "Version 1.0.0", Body: "First release.",
+			Assets: []MockAttachment{{ID: 950, Name: "app.tar.gz", UUID: "uuid-r", Data: []byte("archive")}},
+		},
+		{ID: 901, TagName: "v1.1.0-rc1", Target: "main", Name: "Version 1.1.0 RC1", Prerelease: true},
+		{ID: 902, TagName: "v1.1.0", Target: "main", Name: "Version 1.1.0", Draft: true},
+	})
+}
+
+// findRelease returns the mock release of a tag
+func findRelease(mock *MockGiteaServer, tag string) (MockRelease, bool) {
+	releases := mock.Releases("testuser", "testrepo")
+	index := slices.IndexFunc(releases, func(release MockRelease) bool { return release.TagName == tag })
+	if index < 0 {
+		return MockRelease{}, false
+	}
+	return releases[index], true
+}
+
+// releaseAssetNames returns the names of the assets of a mock release
+func releaseAssetNames(release MockRelease) []string {
+	var names []string
+	for _, asset := range release.Assets {
+		names = append(names, asset.Name)
+	}
+	return names
+}
+
+func TestReleaseList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantTags   []string
+		wantTotal  float64
+		wantError  bool
+	}{
+		{
+			name:       "all releases newest first (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			wantText:   "Found 3 releases",
+			wantTags:   []string{"v1.1.0", "v1.1.0-rc1", "v1.0.0"},
+			wantTotal:  3,
+		},
+		{
+			name:       "second page (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "limit": 2, "offset": 2},
+			wantText:   "Found 1 releases",
+			wantTags:   []string{"v1.0.0"},
+			wantTotal:  3,
+		},
+		{
+			name:      "error: invalid limit",
+			arguments: map[string]any{"repository": "testuser/testrepo", "limit": 101},
+			wantText:  "Invalid request: limit: must be no greater than 100.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "release_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call release_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			var tags []string
+			releases, _ := structured["releases"].([]any)
+			for _, r := range releases {
+				release := r.(map[string]any)
+				tags = append(tags, release["tag_name"].(string))
+				if release["tag_name"] == "v1.1.0" && release["draft"] != true {
+					t.Errorf("expected v1.1.0 to be a draft, got %v", release)
+				}
+				if assets, _ := release["assets"].([]any); release["tag_name"] == "v1.0.0" && len(assets) != 1 {
+					t.Errorf("expected v1.0.0 to have one asset, got %v", release)
+				}
+			}
+			if !cmp.Equal(tc.wantTags, tags) {
+				t.Error(cmp.Diff(tc.wantTags, tags))
+			}
+			if structured["total"] != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, structured["total"])
+			}
+		})
+	}
+}
+
+func TestReleaseGet(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantAssets []any
+		wantError  bool
+	}{
+		{
+			name:       "release with asset (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "tag": "v1.0.0"},
+			wantText:   "Release v1.0.0: Version 1.0.0",
+			wantAssets: []any{"app.tar.gz"},
+		},
+		{
+			name:       "draft release (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "tag": "v1.1.0"},
+			wantText:   "Release v1.1.0: Version 1.1.0",
+		},
+		{
+			name:       "error: unknown tag (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "tag": "v9.0.0"},
+			wantText:   "Failed to get release: release not found for tag v9.0.0",
+			wantError:  true,
+		},
+		{
+			name:      "error: missing tag",
+			arguments: map[string]any{"repository": "testuser/testrepo"},
+			wantText:  "Invalid request: tag: tag is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "release_get",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call release_get tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			release, _ := GetStructuredContent(result)["release"].(map[string]any)
+			var assets []any
+			releaseAssets, _ := release["assets"].([]any)
+			for _, a := range releaseAssets {
+				assets = append(assets, a.(map[string]any)["name"])
+			}
+			if !cmp.Equal(tc.wantAssets, assets) {
+				t.Error(cmp.Diff(tc.wantAssets, assets))
+			}
+		})
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	sum := sha256.Sum256(testPNG)
+	pngName := fmt.Sprintf("attachment-%x.png", sum[:4])
+	image := map[string]any{"type": "image", "mimeType": "image/png", "data": base64.StdEncoding.EncodeToString(testPNG)}
+	zip := map[string]any{"type": "resource", "resource": map[string]any{"uri": "file:///tmp/dist/app.zip", "mimeType": "application/zip", "blob": base64.StdEncoding.EncodeToString([]byte("PK\x03\x04archive"))}}
+
+	testCases := []struct {
+		name        string
+		clientType  string
+		env         map[string]string
+		compat      bool
+		arguments   map[string]any
+		wantText    string
+		wantRelease MockRelease
+		wantAssets  []string
+		wantTag     string // Commit SHA of the tag created with the release
+		wantError   bool
+	}{
+		{
+			name:       "prerelease from branch (gitea)",
+			clientType: "gitea",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"tag":        "v2.0.0-rc1",
+				"target":     "main",
+				"name":       "Version 2.0.0 RC1",
+				"body":       "## Changes\n- Add feature",
+				"prerelease": true,
+			},
+			wantText:    "Release created successfully: v2.0.0-rc1",
+			wantRelease: MockRelease{TagName: "v2.0.0-rc1", Target: "main", Name: "Version 2.0.0 RC1", Body: "## Changes\n- Add feature", Prerelease: true},
+			wantTag:     branchTestCommit("2", "").SHA,
+		},
+		{
+			name:       "draft with assets named after the tag (forgejo)",
+			clientType: "forgejo",
+			env: map[string]string{
+				"FORGEJO_ATTACHMENT_ENABLED":       "true",
+				"FORGEJO_ATTACHMENT_ALLOWED_TYPES": "image/*,application/zip",
+			},
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"tag":         "v2.0.0",
+				"draft":       true,
+				"attachments": []any{zip, image},
+			},
+			wantText:    "Release created successfully: v2.0.0",
+			wantRelease: MockRelease{TagName: "v2.0.0", Target: "main", Name: "v2.0.0", Draft: true},
+			wantAssets:  []string{"app.zip", pngName},
+		},
+		{
+			name:       "compat mode (forgejo)",
+			clientType: "forgejo",
+			compat:     true,
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"tag":        "v2.0.0",
+				"name":       "Version 2.0.0",
+				"body":       "## Changes\n- Add feature",
+			},
+			wantText:    "v2.0.0: Version 2.0.0\nAuthor: testuser\nPublished: 2025-09-14T10:30:00Z\n\n## Changes\n- Add feature\n",
+			wantRelease: MockRelease{TagName: "v2.0.0", Target: "main", Name: "Version 2.0.0", Body: "## Changes\n- Add feature"},
+			wantTag:     branchTestCommit("2", "").SHA,
+		},
+		{
+			name: "error: asset type not allowed",
+			env:  map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			arguments: map[string]any{
+				"repository":  "testuser/testrepo",
+				"tag":         "v2.0.0",
+				"attachments": []any{zip},
+			},
+			wantText:  "Invalid attachment: attachment 0: MIME type application/zip not allowed",
+			wantError: true,
+		},
+		{
+			name:      "error: attachments disabled",
+			arguments: map[string]any{"repository": "testuser/testrepo", "tag": "v2.0.0", "attachments": []any{image}},
+			wantText:  "Invalid attachment: attachments are disabled (set attachment.enabled to permit uploads)",
+			wantError: true,
+		},
+		{
+			name:       "error: release exists (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "tag": "v1.0.0"},
+			wantText:   "Failed to create release: failed to create release: Release has the same tag name",
+			wantError:  true,
+		},
+		{
+			name:      "error: missing tag",
+			arguments: map[string]any{"repository": "testuser/testrepo", "name": "Version 2"},
+			wantText:  "Invalid request: tag: tag is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			for key, value := range tc.env {
+				env[key] = value
+			}
+			ts := NewTestServerWithCompat(t, ctx, env, tc.compat)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "release_create",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call release_create tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				if len(mock.Releases("testuser", "testrepo")) != 3 {
+					t.Errorf("expected no release to be created, got %v", mock.Releases("testuser", "testrepo"))
+				}
+				return
+			}
+
+			release, ok := findRelease(mock, tc.wantRelease.TagName)
+			if !ok {
+				t.Fatalf("expected release %s to be created", tc.wantRelease.TagName)
+			}
+			if assets := releaseAssetNames(release); !cmp.Equal(tc.wantAssets, assets) {
+				t.Error(cmp.Diff(tc.wantAssets, assets))
+			}
+			release.ID, release.Assets = 0, nil
+			if !cmp.Equal(tc.wantRelease, release) {
+				t.Error(cmp.Diff(tc.wantRelease, release))
+			}
+
+			var tagSHA string
+			for _, tag := range mock.Tags("testuser", "testrepo") {
+				if tag.Name == tc.wantRelease.TagName {
+					tagSHA = tag.CommitSHA
+				}
+			}
+			if tagSHA != tc.wantTag {
+				t.Errorf("expected tag at %q, got %q", tc.wantTag, tagSHA)
+			}
+
+			structured, _ := GetStructuredContent(result)["release"].(map[string]any)
+			if assets, _ := structured["assets"].([]any); len(assets) != len(tc.wantAssets) {
+				t.Errorf("expected %d assets in the result, got %v", len(tc.wantAssets), structured)
+			}
+		})
+	}
+}
+
+func TestReleaseEdit(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	sum := sha256.Sum256(testPNG)
+	pngName := fmt.Sprintf("attachment-%x.png", sum[:4])
+	image := map[string]any{"type": "image", "mimeType": "image/png", "data": base64.StdEncoding.EncodeToString(testPNG)}
+
+	testCases := []struct {
+		name        string
+		clientType  string
+		env         map[string]string
+		compat      bool
+		arguments   map[string]any
+		wantText    string
+		wantRelease MockRelease
+		wantAssets  []string
+		wantError   bool
+	}{
+		{
+			name:        "publish draft (gitea)",
+			clientType:  "gitea",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "tag": "v1.1.0", "draft": false},
+			wantText:    "Release edited successfully: v1.1.0 (published)",
+			wantRelease: MockRelease{TagName: "v1.1.0", Target: "main", Name: "Version 1.1.0"},
+		},
+		{
+			name:       "rename and rewrite notes (forgejo)",
+			clientType: "forgejo",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"tag":        "v1.1.0-rc1",
+				"name":       "Version 1.1.0 Release Candidate",
+				"body":       "Please test.",
+				"prerelease": false,
+			},
+			wantText:    "Release edited successfully: v1.1.0-rc1 (published)",
+			wantRelease: MockRelease{TagName: "v1.1.0-rc1", Target: "main", Name: "Version 1.1.0 Release Candidate", Body: "Please test."},
+		},
+		{
+			name:        "add asset only (gitea)",
+			clientType:  "gitea",
+			env:         map[string]string{"FORGEJO_ATTACHMENT_ENABLED": "true"},
+			arguments:   map[string]any{"repository": "testuser/testrepo", "tag": "v1.0.0", "attachments": []any{image}},
+			wantText:    "Release edited successfully: v1.0.0 (published)",
+			wantRelease: MockRelease{TagName: "v1.0.0", Target: "main", Name: "Version 1.0.0", Body: "First release."},
+			wantAssets:  []string{"app.tar.gz", pngName},
+		},
+		{
+			name:        "compat mode (gitea)",
+			clientType:  "gitea",
+			compat:      true,
+			arguments:   map[string]any{"repository": "testuser/testrepo", "tag": "v1.1.0", "draft": false},
+			wantText:    "v1.1.0: Version 1.1.0\nAuthor: testuser\nPublished: 2025-09-14T10:30:00Z\n",
+			wantRelease: MockRelease{TagName: "v1.1.0", Target: "main", Name: "Version 1.1.0"},
+		},
+		{
+			name:       "error: unknown tag (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "tag": "v9.0.0", "name": "Nine"},
+			wantText:   "Failed to edit release: failed to edit release: release not found for tag v9.0.0",
+			wantError:  true,
+		},
+		{
+			name:      "error: nothing to change",
+			arguments: map[string]any{"repository": "testuser/testrepo", "tag": "v1.0.0"},
+			wantText:  "At least one of new_tag, target, name, body, draft, prerelease, or attachments must be provided",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			for key, value := range tc.env {
+				env[key] = value
+			}
+			ts := NewTestServerWithCompat(t, ctx, env, tc.compat)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "release_edit",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call release_edit tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			release, ok := findRelease(mock, tc.wantRelease.TagName)
+			if !ok {
+				t.Fatalf("expected release %s", tc.wantRelease.TagName)
+			}
+			if tc.wantAssets != nil {
+				if assets := releaseAssetNames(release); !cmp.Equal(tc.wantAssets, assets) {
+					t.Error(cmp.Diff(tc.wantAssets, assets))
+				}
+			}
+			release.ID, release.Assets = 0, nil
+			if !cmp.Equal(tc.wantRelease, release) {
+				t.Error(cmp.Diff(tc.wantRelease, release))
+			}
+		})
+	}
+}
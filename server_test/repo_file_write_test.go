@@ -0,0 +1,259 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// readmeSHA is the blob SHA of the README.md added by addRepoFileTestData
+const readmeSHA = "d854c43cbf5c5d7e4fbfbc965561d4ade8140c94"
+
+func TestRepoFileWrite(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name        string
+		clientType  string
+		arguments   map[string]any
+		wantText    string
+		wantError   bool
+		wantAction  string
+		wantBranch  string
+		wantContent string
+		wantCommit  MockFileCommit
+	}{
+		{
+			name:        "create file (gitea)",
+			clientType:  "gitea",
+			arguments:   map[string]any{"repository": "testuser/testrepo", "path": "docs/new.md", "content": "New\n", "message": "Add new doc"},
+			wantText:    "File docs/new.md created on the default branch",
+			wantAction:  "created",
+			wantBranch:  "main",
+			wantContent: "New\n",
+			wantCommit:  MockFileCommit{Branch: "main", Path: "docs/new.md", Message: "Add new doc"},
+		},
+		{
+			name:       "update file with author (forgejo)",
+			clientType: "forgejo",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "README.md", "content": "# Updated\n", "message": "Update readme",
+				"sha": readmeSHA, "author": map[string]any{"name": "Alice", "email": "alice@example.com"},
+			},
+			wantText:    "File README.md updated on the default branch",
+			wantAction:  "updated",
+			wantBranch:  "main",
+			wantContent: "# Updated\n",
+			wantCommit:  MockFileCommit{Branch: "main", Path: "README.md", Message: "Update readme", AuthorName: "Alice", AuthorEmail: "alice@example.com"},
+		},
+		{
+			name: "update file on new branch",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "README.md", "content": "# Branch\n", "message": "Update readme",
+				"sha": readmeSHA, "branch": "main", "new_branch": "docs-update",
+			},
+			wantText:    "File README.md updated on docs-update",
+			wantAction:  "updated",
+			wantBranch:  "docs-update",
+			wantContent: "# Branch\n",
+			wantCommit:  MockFileCommit{Branch: "docs-update", Path: "README.md", Message: "Update readme"},
+		},
+		{
+			name: "create binary file from base64",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "data.bin", "content": "AAEC/w==", "encoding": "base64", "message": "Add data",
+			},
+			wantText:    "File data.bin created on the default branch",
+			wantAction:  "created",
+			wantBranch:  "main",
+			wantContent: "\x00\x01\x02\xff",
+			wantCommit:  MockFileCommit{Branch: "main", Path: "data.bin", Message: "Add data"},
+		},
+		{
+			name: "error: stale sha",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "README.md", "content": "# Stale\n", "message": "Update readme",
+				"sha": "0000000000000000000000000000000000000000",
+			},
+			wantText:  "Refusing to write file: file changed since it was read: README.md has SHA " + readmeSHA + ", not 0000000000000000000000000000000000000000 (read it again with repo_file_get and pass its current sha)",
+			wantError: true,
+		},
+		{
+			name:      "error: create existing file",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "README.md", "content": "# New\n", "message": "Add readme"},
+			wantText:  "Refusing to write file: file changed since it was read: README.md already exists with SHA " + readmeSHA + " (read it again with repo_file_get and pass its current sha)",
+			wantError: true,
+		},
+		{
+			name: "error: author without email",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "new.md", "content": "New\n", "message": "Add new",
+				"author": map[string]any{"name": "Alice"},
+			},
+			wantText:  "Invalid request: author: both name and email must be provided.",
+			wantError: true,
+		},
+		{
+			name:      "error: missing message",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "new.md", "content": "New\n"},
+			wantText:  "Invalid request: message: commit message is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addRepoFileTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "repo_file_write",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call repo_file_write tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			commits := mock.FileCommits("testuser", "testrepo")
+			if tc.wantError {
+				if len(commits) != 0 {
+					t.Errorf("expected no commits, got %d", len(commits))
+				}
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			if structured["action"] != tc.wantAction {
+				t.Errorf("expected action %q, got %q", tc.wantAction, structured["action"])
+			}
+			commit, _ := structured["commit"].(map[string]any)
+			if commit["sha"] == "" || commit["commit_sha"] == "" {
+				t.Errorf("expected file and commit SHAs, got %v", commit)
+			}
+
+			content, ok := mock.FileContent("testuser", "testrepo", tc.wantBranch, tc.arguments["path"].(string))
+			if !ok || string(content) != tc.wantContent {
+				t.Errorf("expected content %q on %s, got %q (exists: %v)", tc.wantContent, tc.wantBranch, content, ok)
+			}
+			if len(commits) != 1 {
+				t.Fatalf("expected 1 commit, got %d", len(commits))
+			}
+			tc.wantCommit.SHA = commits[0].SHA
+			if commits[0] != tc.wantCommit {
+				t.Errorf("expected commit %+v, got %+v", tc.wantCommit, commits[0])
+			}
+		})
+	}
+}
+
+func TestRepoFileDelete(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantError  bool
+	}{
+		{
+			name:       "delete file (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "README.md", "message": "Remove readme", "sha": readmeSHA},
+			wantText:   "File README.md deleted on the default branch",
+		},
+		{
+			name:       "delete file on branch (forgejo)",
+			clientType: "forgejo",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo", "path": "README.md", "message": "Remove readme",
+				"sha": "14c606ed031cbe4b004f1ae8a1aa895a484a14bb", "branch": "feature",
+			},
+			wantText: "File README.md deleted on feature",
+		},
+		{
+			name:      "error: stale sha",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "README.md", "message": "Remove readme", "sha": "14c606ed031cbe4b004f1ae8a1aa895a484a14bb"},
+			wantText:  "Refusing to delete file: file changed since it was read: README.md has SHA " + readmeSHA + ", not 14c606ed031cbe4b004f1ae8a1aa895a484a14bb (read it again with repo_file_get and pass its current sha)",
+			wantError: true,
+		},
+		{
+			name:      "error: missing file",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "gone.md", "message": "Remove file", "sha": readmeSHA},
+			wantText:  "Refusing to delete file: file changed since it was read: gone.md no longer exists (read it again with repo_file_get and pass its current sha)",
+			wantError: true,
+		},
+		{
+			name:      "error: missing sha",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "README.md", "message": "Remove readme"},
+			wantText:  "Invalid request: sha: sha is required, read it with repo_file_get.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addRepoFileTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "repo_file_delete",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call repo_file_delete tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			branch, _ := tc.arguments["branch"].(string)
+			if branch == "" {
+				branch = "main"
+			}
+			if _, ok := mock.FileContent("testuser", "testrepo", branch, "README.md"); ok {
+				t.Errorf("expected README.md to be deleted from %s", branch)
+			}
+			if commits := mock.FileCommits("testuser", "testrepo"); len(commits) != 1 || !commits[0].Deleted {
+				t.Errorf("expected 1 delete commit, got %+v", commits)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package servertest
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func addRepoFileTestData(mock *MockGiteaServer) {
+	mock.AddFile("testuser", "testrepo", "main", "README.md", []byte("# Test\n\nLine three\nLine four\n"))
+	mock.AddFile("testuser", "testrepo", "main", "docs/guide.md", []byte("Guide\n"))
+	mock.AddFile("testuser", "testrepo", "main", "docs/api/index.md", []byte("API\n"))
+	mock.AddFile("testuser", "testrepo", "main", "logo.png", testPNG)
+	mock.AddFile("testuser", "testrepo", "feature", "README.md", []byte("# Feature\n"))
+}
+
+func TestRepoFileGet(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	readme := map[string]any{"path": "README.md", "name": "README.md", "sha": "d854c43cbf5c5d7e4fbfbc965561d4ade8140c94", "size": float64(29)}
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		env        map[string]string
+		expect     *mcp.CallToolResult
+	}{
+		{
+			name:       "line range (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "README.md", "start_line": 3, "end_line": 10},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Returned lines 3-4 of 4 from README.md"},
+				},
+				StructuredContent: map[string]any{
+					"file":        readme,
+					"content":     "Line three\nLine four\n",
+					"binary":      false,
+					"total_lines": float64(4),
+					"start_line":  float64(3),
+					"end_line":    float64(4),
+				},
+			},
+		},
+		{
+			name:       "file at ref (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "/README.md", "ref": "feature"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Returned lines 1-1 of 1 from README.md"},
+				},
+				StructuredContent: map[string]any{
+					"file":        map[string]any{"path": "README.md", "name": "README.md", "sha": "14c606ed031cbe4b004f1ae8a1aa895a484a14bb", "size": float64(10), "ref": "feature"},
+					"content":     "# Feature\n",
+					"binary":      false,
+					"total_lines": float64(1),
+					"start_line":  float64(1),
+					"end_line":    float64(1),
+				},
+			},
+		},
+		{
+			name:      "binary file",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "logo.png"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "File logo.png is binary (24 bytes)"},
+				},
+				StructuredContent: map[string]any{
+					"file":   map[string]any{"path": "logo.png", "name": "logo.png", "sha": "64c3c7136b16621b8a43139414f20d85fb7782be", "size": float64(24)},
+					"binary": true,
+				},
+			},
+		},
+		{
+			name:      "error: start line beyond end",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "README.md", "start_line": 9},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: start_line 9 is beyond the end of the file (4 lines)"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: end line before start line",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "README.md", "start_line": 3, "end_line": 2},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: end_line: end_line must not be before start_line."},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: directory",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "docs"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to get file: failed to get file docs: expect file, got directory"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:       "error: file over size limit (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "README.md"},
+			env:        map[string]string{"FORGEJO_MAX_FILE_SIZE": "16"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Failed to get file: file README.md is 29 bytes, exceeding the 16 byte limit"},
+				},
+				IsError: true,
+			},
+		},
+		{
+			name:      "error: missing path",
+			arguments: map[string]any{"repository": "testuser/testrepo"},
+			expect: &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid request: path: path is required."},
+				},
+				IsError: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addRepoFileTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			maps.Copy(env, tc.env)
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "repo_file_get",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call repo_file_get tool: %v", err)
+			}
+
+			if !cmp.Equal(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})) {
+				t.Error(cmp.Diff(tc.expect, result, cmpopts.IgnoreUnexported(mcp.TextContent{})))
+			}
+		})
+	}
+}
+
+func TestRepoTreeList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantPaths  []string
+		truncated  bool
+		wantError  bool
+	}{
+		{
+			name:       "root of default branch (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			wantText:   "Found 3 entries at main",
+			wantPaths:  []string{"README.md", "docs", "logo.png"},
+		},
+		{
+			name:       "recursive subdirectory (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "path": "docs", "recursive": true},
+			wantText:   "Found 3 entries at main",
+			wantPaths:  []string{"docs/api", "docs/api/index.md", "docs/guide.md"},
+		},
+		{
+			name:      "recursive with limit",
+			arguments: map[string]any{"repository": "testuser/testrepo", "ref": "main", "recursive": true, "limit": 2},
+			wantText:  "Found 2 entries at main",
+			wantPaths: []string{"README.md", "docs"},
+			truncated: true,
+		},
+		{
+			name:      "error: unknown directory",
+			arguments: map[string]any{"repository": "testuser/testrepo", "path": "src"},
+			wantText:  "Failed to list tree: directory src not found at main",
+			wantError: true,
+		},
+		{
+			name:      "error: limit too large",
+			arguments: map[string]any{"repository": "testuser/testrepo", "limit": 10000},
+			wantText:  "Invalid request: limit: must be no greater than 5000.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addRepoFileTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "repo_tree_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call repo_tree_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			var paths []string
+			entries, _ := structured["entries"].([]any)
+			for _, e := range entries {
+				entry := e.(map[string]any)
+				paths = append(paths, entry["path"].(string))
+				if entry["sha"] == "" {
+					t.Errorf("entry %s has no SHA", entry["path"])
+				}
+			}
+			if !cmp.Equal(tc.wantPaths, paths) {
+				t.Error(cmp.Diff(tc.wantPaths, paths))
+			}
+			if structured["truncated"] != tc.truncated {
+				t.Errorf("expected truncated %v, got %v", tc.truncated, structured["truncated"])
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package servertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestTagList(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantNames  []string
+		wantTotal  float64
+		wantError  bool
+	}{
+		{
+			name:       "all tags newest first (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo"},
+			wantText:   "Found 2 tags",
+			wantNames:  []string{"v1.1.0-rc1", "v1.0.0"},
+			wantTotal:  2,
+		},
+		{
+			name:       "second page (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "limit": 1, "offset": 1},
+			wantText:   "Found 1 tags",
+			wantNames:  []string{"v1.0.0"},
+			wantTotal:  2,
+		},
+		{
+			name:      "error: missing repository",
+			arguments: map[string]any{},
+			wantText:  "Invalid request: directory: at least one of directory or repository must be provided; repository: at least one of directory or repository must be provided.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "tag_list",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call tag_list tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			if tc.wantError {
+				return
+			}
+
+			structured := GetStructuredContent(result)
+			var names []string
+			tags, _ := structured["tags"].([]any)
+			for _, tg := range tags {
+				tag := tg.(map[string]any)
+				names = append(names, tag["name"].(string))
+				if sha, _ := tag["commit_sha"].(string); len(sha) != 40 {
+					t.Errorf("expected the commit of %s, got %v", tag["name"], tag)
+				}
+			}
+			if !cmp.Equal(tc.wantNames, names) {
+				t.Error(cmp.Diff(tc.wantNames, names))
+			}
+			if structured["total"] != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, structured["total"])
+			}
+		})
+	}
+}
+
+func TestTagCreate(t *testing.T) {
+	// Note: t.Parallel() disabled due to incompatibility with t.Setenv() used in test harness
+	testCases := []struct {
+		name       string
+		clientType string
+		arguments  map[string]any
+		wantText   string
+		wantTag    MockTag
+		wantError  bool
+	}{
+		{
+			name:       "lightweight tag of default branch (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "v1.1.0"},
+			wantText:   "Created tag v1.1.0 at " + branchTestCommit("2", "").SHA,
+			wantTag:    MockTag{Name: "v1.1.0", CommitSHA: branchTestCommit("2", "").SHA},
+		},
+		{
+			name:       "annotated tag of commit (forgejo)",
+			clientType: "forgejo",
+			arguments: map[string]any{
+				"repository": "testuser/testrepo",
+				"name":       "v1.0.1",
+				"target":     branchTestCommit("1", "").SHA,
+				"message":    "Patch release",
+			},
+			wantText: "Created tag v1.0.1 at " + branchTestCommit("1", "").SHA,
+			wantTag:  MockTag{Name: "v1.0.1", CommitSHA: branchTestCommit("1", "").SHA, Message: "Patch release"},
+		},
+		{
+			name:       "error: tag exists (gitea)",
+			clientType: "gitea",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "v1.0.0"},
+			wantText:   "Failed to create tag: failed to create tag v1.0.0: tag v1.0.0 already exists",
+			wantError:  true,
+		},
+		{
+			name:       "error: unknown target (forgejo)",
+			clientType: "forgejo",
+			arguments:  map[string]any{"repository": "testuser/testrepo", "name": "v2.0.0", "target": "missing"},
+			wantText:   "Failed to create tag: failed to create tag v2.0.0: target missing not found",
+			wantError:  true,
+		},
+		{
+			name:      "error: missing name",
+			arguments: map[string]any{"repository": "testuser/testrepo"},
+			wantText:  "Invalid request: name: tag name is required.",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			mock := NewMockGiteaServer(t)
+			addReleaseTestData(mock)
+
+			env := map[string]string{
+				"FORGEJO_REMOTE_URL": mock.URL(),
+				"FORGEJO_AUTH_TOKEN": "mock-token",
+			}
+			if tc.clientType != "" {
+				env["FORGEJO_CLIENT_TYPE"] = tc.clientType
+			}
+			ts := NewTestServer(t, ctx, env)
+			if err := ts.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize test server: %v", err)
+			}
+
+			result, err := ts.Client().CallTool(ctx, &mcp.CallToolParams{
+				Name:      "tag_create",
+				Arguments: tc.arguments,
+			})
+			if err != nil {
+				t.Fatalf("Failed to call tag_create tool: %v", err)
+			}
+
+			if text := GetTextContent(result.Content); result.IsError != tc.wantError || text != tc.wantText {
+				t.Fatalf("expected %q (is error: %v), got %q (is error: %v)", tc.wantText, tc.wantError, text, result.IsError)
+			}
+			tags := mock.Tags("testuser", "testrepo")
+			if tc.wantError {
+				if len(tags) != 2 {
+					t.Errorf("expected no tag to be created, got %v", tags)
+				}
+				return
+			}
+
+			if got := tags[len(tags)-1]; !cmp.Equal(tc.wantTag, got) {
+				t.Error(cmp.Diff(tc.wantTag, got))
+			}
+		})
+	}
+}
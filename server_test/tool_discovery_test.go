@@ -31,27 +31,77 @@ func TestToolDiscovery(t *testing.T) {
 	}
 
 	// Validate total tool count (hello tool is only available in debug mode)
-	expectedToolCount := 14
+	expectedToolCount := 64
 	if len(tools.Tools) != expectedToolCount {
 		t.Fatalf("Expected %d tools, got %d", expectedToolCount, len(tools.Tools))
 	}
 
 	// Define expected tools with their descriptions (hello tool only in debug mode)
 	expectedTools := map[string]string{
-		"issue_list":           "List issues from a Gitea/Forgejo repository",
-		"issue_create":         "Create a new issue on a Forgejo/Gitea repository",
-		"issue_comment_create": "Create a comment on a Forgejo/Gitea repository issue",
-		"issue_comment_list":   "List comments from a Forgejo/Gitea repository issue with pagination support",
-		"issue_comment_edit":   "Edit an existing comment on a Forgejo/Gitea repository issue",
-		"issue_edit":           "Edit an existing issue in a Forgejo/Gitea repository",
-		"pr_list":              "List pull requests from a Forgejo/Gitea repository with pagination and state filtering",
-		"pr_fetch":             "Fetch detailed information about a single pull request from a Forgejo/Gitea repository",
-		"pr_comment_list":      "List comments from a Forgejo/Gitea repository pull request with pagination support",
-		"pr_comment_create":    "Create a comment on a Forgejo/Gitea repository pull request",
-		"pr_comment_edit":      "Edit an existing comment on a Forgejo/Gitea repository pull request",
-		"pr_edit":              "Edit an existing pull request in a Forgejo/Gitea repository",
-		"pr_create":            "Create a new pull request in a Forgejo/Gitea repository",
-		"notification_list":    "List notifications from a Git repository with optional filtering",
+		"issue_list":               "List issues from a Gitea/Forgejo repository",
+		"issue_fetch":              "Fetch detailed information about a single issue from a Forgejo/Gitea repository",
+		"issue_timeline":           "List the comments and events of an issue or pull request in chronological order",
+		"search_issues":            "Search issues and pull requests across all repositories visible to the authenticated user",
+		"issue_create":             "Create a new issue on a Forgejo/Gitea repository",
+		"issue_comment_create":     "Create a comment on a Forgejo/Gitea repository issue",
+		"issue_comment_list":       "List comments from a Forgejo/Gitea repository issue with pagination support",
+		"issue_comment_edit":       "Edit an existing comment on a Forgejo/Gitea repository issue",
+		"issue_comment_delete":     "Delete a comment from a Forgejo/Gitea repository issue; comments by other users are refused unless allowed in the configuration",
+		"issue_edit":               "Edit an existing issue in a Forgejo/Gitea repository",
+		"pr_list":                  "List pull requests from a Forgejo/Gitea repository with pagination and state filtering",
+		"pr_fetch":                 "Fetch detailed information about a single pull request from a Forgejo/Gitea repository",
+		"pr_comment_list":          "List comments from a Forgejo/Gitea repository pull request with pagination support",
+		"pr_comment_create":        "Create a comment on a Forgejo/Gitea repository pull request",
+		"pr_comment_edit":          "Edit an existing comment on a Forgejo/Gitea repository pull request",
+		"pr_comment_delete":        "Delete a comment from a Forgejo/Gitea repository pull request; comments by other users are refused unless allowed in the configuration",
+		"pr_edit":                  "Edit an existing pull request in a Forgejo/Gitea repository",
+		"pr_create":                "Create a new pull request in a Forgejo/Gitea repository",
+		"pr_merge":                 "Merge a pull request in a Forgejo/Gitea repository, or schedule it to merge when checks succeed",
+		"pr_review_create":         "Submit a review (approve, request changes, or comment) with optional inline comments on a Forgejo/Gitea pull request",
+		"pr_review_list":           "List reviews on a Forgejo/Gitea pull request with pagination support",
+		"pr_review_comments_list":  "List inline comments of a review on a Forgejo/Gitea pull request",
+		"pr_reviewer_request":      "Request reviews on a Forgejo/Gitea pull request from users or teams",
+		"pr_reviewer_remove":       "Remove pending review requests for users or teams from a Forgejo/Gitea pull request",
+		"pr_diff":                  "Fetch the unified diff of a Forgejo/Gitea pull request, optionally limited to given paths, paginated by file with per-file truncation",
+		"pr_files":                 "List files changed by a Forgejo/Gitea pull request with status, additions, and deletions",
+		"commit_status":            "Get the combined CI status and individual check contexts for a ref or pull request head in a Forgejo/Gitea repository",
+		"action_run_list":          "List Forgejo Actions workflow runs for a repository, optionally filtered by branch, commit, status, or event",
+		"action_job_list":          "List the jobs of a Forgejo Actions workflow run",
+		"action_job_log":           "Fetch the log of a Forgejo Actions job with optional regular expression filtering and tail limit",
+		"label_list":               "List the labels defined in a repository",
+		"label_create":             "Create a new label in a repository",
+		"label_edit":               "Rename a repository label or change its color or description",
+		"issue_label_add":          "Add labels by name to an issue or pull request",
+		"issue_label_remove":       "Remove labels by name from an issue or pull request",
+		"milestone_list":           "List the milestones of a repository filtered by state",
+		"milestone_create":         "Create a new milestone in a repository with optional description and due date",
+		"milestone_edit":           "Change the title, description, due date, or state of a milestone identified by title",
+		"reaction_list":            "List the emoji reactions on an issue or pull request, or on one of its comments, grouped by emoji",
+		"reaction_add":             "Add an emoji reaction to an issue or pull request, or to one of its comments",
+		"reaction_remove":          "Remove your emoji reaction from an issue or pull request, or from one of its comments",
+		"attachment_list":          "List the files attached to an issue or pull request, or to one of its comments",
+		"attachment_get":           "Download a file attached to an issue, pull request, or comment, returned as image content for images and as resource content for logs and other files",
+		"notification_list":        "List notifications from a Git repository with optional filtering",
+		"notification_digest":      "Summarize unread notifications by repository and reason (review requested, mention, assigned, failing CI) as a prioritized work list with latest comment excerpts",
+		"notification_mark":        "Mark a notification thread as read, unread, or pinned, or mark all unread notifications of a repository as read",
+		"notification_subscribe":   "Subscribe to the notifications of an issue or pull request thread",
+		"notification_unsubscribe": "Unsubscribe from the notifications of an issue or pull request thread",
+		"repo_file_get":            "Read a file of a repository at a branch, tag, or commit, optionally limited to a range of lines; binary files return only metadata",
+		"repo_tree_list":           "List the files and directories of a repository at a branch, tag, or commit, optionally recursive, with sizes and SHAs",
+		"repo_file_write":          "Create or update a file on a branch in one commit without a local clone; pass the sha from repo_file_get to update so concurrent edits are rejected",
+		"repo_file_delete":         "Delete a file from a branch in one commit without a local clone; the sha from repo_file_get must match the current file",
+		"branch_list":              "List the branches of a remote repository with their last commit and protection status",
+		"branch_create":            "Create a branch on the remote repository from a branch, tag or commit",
+		"branch_delete":            "Delete a branch from the remote repository",
+		"branch_compare":           "Compare two branches on the remote, returning how many commits head is ahead and behind base and the differing commits",
+		"commit_list":              "List the commits of a repository by branch, path, author and date range, or the commits of a pull request",
+		"commit_get":               "Get a single commit with its message, author, stats and changed files, optionally with its diff",
+		"release_list":             "List the releases of a repository with their draft and prerelease flags and assets",
+		"release_get":              "Get the release of a tag with its notes and assets",
+		"release_create":           "Create a release for a tag, creating the tag from a target branch or commit if needed, with notes, draft and prerelease flags and uploaded assets",
+		"release_edit":             "Edit the tag, title, notes, or draft and prerelease flags of a release, or upload additional assets",
+		"tag_list":                 "List the git tags of a repository with their commits",
+		"tag_create":               "Create a lightweight or annotated git tag at a branch or commit",
 	}
 
 	// Track found tools for validation